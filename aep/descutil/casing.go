@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 // ToKebabCase returns the kebob-case of a word (book-edition).
 func ToKebabCase(s string) string {
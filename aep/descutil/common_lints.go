@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 import (
 	"fmt"
@@ -95,11 +95,17 @@ func LintFieldPresentAndSingularString(field string) func(*desc.MessageDescripto
 }
 
 func lintFieldBehavior(f *desc.FieldDescriptor, want string, wantMessage string) []lint.Problem {
-	if !GetFieldBehavior(f).Contains(want) {
-		return []lint.Problem{{
+	behaviors := GetFieldBehavior(f)
+	if !behaviors.Contains(want) {
+		problem := lint.Problem{
 			Message:    fmt.Sprintf("The `%s` field should include `(aep.api.field_info).field_behavior = %s`.", f.GetName(), wantMessage),
 			Descriptor: f,
-		}}
+		}
+		// If the field declares other behaviors, point at its options.
+		if behaviors.Len() > 0 {
+			problem.Location = locations.FieldOptions(f)
+		}
+		return []lint.Problem{problem}
 	}
 	return nil
 }
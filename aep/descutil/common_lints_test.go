@@ -1,9 +1,9 @@
-package utils
+package descutil
 
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc/builder"
 )
 
@@ -11,14 +11,14 @@ func TestLintSingularStringField(t *testing.T) {
 	for _, test := range []struct {
 		testName  string
 		FieldType string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
 		{"Valid", `string`, nil},
-		{"Invalid", `int32`, testutils.Problems{{Suggestion: "string"}}},
-		{"InvalidRepeated", `repeated string`, testutils.Problems{{Suggestion: "string"}}},
+		{"Invalid", `int32`, ruletest.Problems{{Suggestion: "string"}}},
+		{"InvalidRepeated", `repeated string`, ruletest.Problems{{Suggestion: "string"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message Message {
 					{{.FieldType}} foo = 1;
 				}
@@ -36,13 +36,13 @@ func TestLintRequiredField(t *testing.T) {
 	for _, test := range []struct {
 		testName   string
 		Annotation string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", `[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED]`, nil},
-		{"Invalid", ``, testutils.Problems{{Message: "REQUIRED"}}},
+		{"Invalid", ``, ruletest.Problems{{Message: "REQUIRED"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/field_info.proto";
 				message Message {
 					string foo = 1 {{.Annotation}};
@@ -61,13 +61,13 @@ func TestLintFieldResourceReference(t *testing.T) {
 	for _, test := range []struct {
 		testName   string
 		Annotation string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", `[(aep.api.field_info).resource_reference = "bar"]`, nil},
-		{"Invalid", ``, testutils.Problems{{Message: "resource_reference"}}},
+		{"Invalid", ``, ruletest.Problems{{Message: "resource_reference"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "aep/api/field_info.proto";
 				message Message {
@@ -87,13 +87,13 @@ func TestLintNoHTTPBody(t *testing.T) {
 	for _, test := range []struct {
 		testName string
 		Body     string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", ``, nil},
-		{"Invalid", `*`, testutils.Problems{{Message: "not have an HTTP body"}}},
+		{"Invalid", `*`, ruletest.Problems{{Message: "not have an HTTP body"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc GetBook(GetBookRequest) returns (Book) {
@@ -119,13 +119,13 @@ func TestLintWildcardHTTPBody(t *testing.T) {
 	for _, test := range []struct {
 		testName string
 		Body     string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", `*`, nil},
-		{"Invalid", ``, testutils.Problems{{Message: `use "*" as the HTTP body`}}},
+		{"Invalid", ``, ruletest.Problems{{Message: `use "*" as the HTTP body`}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc ArchiveBook(ArchiveBookRequest) returns (Book) {
@@ -151,13 +151,13 @@ func TestLintHTTPMethod(t *testing.T) {
 	for _, test := range []struct {
 		testName string
 		Method   string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", `get`, nil},
-		{"Invalid", `delete`, testutils.Problems{{Message: `HTTP GET`}}},
+		{"Invalid", `delete`, ruletest.Problems{{Message: `HTTP GET`}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc GetBook(GetBookRequest) returns (Book) {
@@ -182,13 +182,13 @@ func TestLintMethodHasMatchingRequestName(t *testing.T) {
 	for _, test := range []struct {
 		testName    string
 		MessageName string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "GetBookRequest", nil},
-		{"Invalid", "AcquireBookRequest", testutils.Problems{{Suggestion: "GetBookRequest"}}},
+		{"Invalid", "AcquireBookRequest", ruletest.Problems{{Suggestion: "GetBookRequest"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc GetBook({{.MessageName}}) returns (Book);
 				}
@@ -208,13 +208,13 @@ func TestLintMethodHasMatchingResponseName(t *testing.T) {
 	for _, test := range []struct {
 		testName    string
 		MessageName string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "GetBookResponse", nil},
-		{"Invalid", "AcquireBookResponse", testutils.Problems{{Suggestion: "GetBookResponse"}}},
+		{"Invalid", "AcquireBookResponse", ruletest.Problems{{Suggestion: "GetBookResponse"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc GetBook(GetBookRequest) returns ({{.MessageName}});
 				}
@@ -234,13 +234,13 @@ func TestLintSingularField(t *testing.T) {
 	for _, test := range []struct {
 		testName string
 		Label    string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "", nil},
-		{"Invalid", "repeated", testutils.Problems{{Suggestion: "string"}}},
+		{"Invalid", "repeated", ruletest.Problems{{Suggestion: "string"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message Message {
 					{{.Label}} string foo = 1;
 				}
@@ -258,13 +258,13 @@ func TestLintNotOneof(t *testing.T) {
 	for _, test := range []struct {
 		testName string
 		Field    string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", `string foo = 1;`, nil},
-		{"Invalid", `oneof foo_oneof { string foo = 1; }`, testutils.Problems{{Message: "should not be a oneof"}}},
+		{"Invalid", `oneof foo_oneof { string foo = 1; }`, ruletest.Problems{{Message: "should not be a oneof"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message Message {
 					{{.Field}}
 				}
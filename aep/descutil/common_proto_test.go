@@ -12,12 +12,12 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestIsCommonProto(t *testing.T) {
@@ -33,7 +33,7 @@ func TestIsCommonProto(t *testing.T) {
 		{"google.cloud.speech.v1", false},
 	} {
 		t.Run(test.Package, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				package {{.Package}};
 			`, test)
 			if got := IsCommonProto(f); got != test.want {
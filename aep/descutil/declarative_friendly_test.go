@@ -12,13 +12,13 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 import (
 	"fmt"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestDeclarativeFriendlyMessage(t *testing.T) {
@@ -33,7 +33,7 @@ func TestDeclarativeFriendlyMessage(t *testing.T) {
 		{"WithResource", false},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3String(t, `
+			f := ruletest.ParseProto3String(t, `
 				import "aep/api/resource.proto";
 
 				message Book {
@@ -62,7 +62,7 @@ func TestDeclarativeFriendlyMessage(t *testing.T) {
 
 	// Test the case where the aep.api.resource annotation is not present.
 	t.Run("NotResource", func(t *testing.T) {
-		m := testutils.ParseProto3String(t, "message Book {}").GetMessageTypes()[0]
+		m := ruletest.ParseProto3String(t, "message Book {}").GetMessageTypes()[0]
 		if IsDeclarativeFriendlyMessage(m) {
 			t.Errorf("Got true, expected false.")
 		}
@@ -143,7 +143,7 @@ func TestDeclarativeFriendlyMethod(t *testing.T) {
 			want := false
 
 			// Parse the template and test the method.
-			f := testutils.ParseProto3String(t, fmt.Sprintf(`
+			f := ruletest.ParseProto3String(t, fmt.Sprintf(`
 				import "aep/api/resource.proto";
 
 				%s
@@ -163,7 +163,7 @@ func TestDeclarativeFriendlyMethod(t *testing.T) {
 
 	// Test an edge case where the LRO response is not found.
 	t.Run("lro/not-found", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "google/longrunning/operations.proto";
 			service Library {
 				rpc CreateBook(CreateBookRequest) returns (google.longrunning.Operation) {
@@ -0,0 +1,8 @@
+// Package descutil provides the helpers the built-in rules use to inspect
+// descriptors: finding resources, HTTP rules, field behaviors and standard
+// methods, and linting common fields. Rules written outside this module can
+// use it to detect resources and methods as the built-in rules do.
+//
+// The package follows the versioning of the module: its exported API only
+// changes in backwards-incompatible ways in a new major version.
+package descutil
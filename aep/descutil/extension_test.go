@@ -12,21 +12,21 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 import (
 	"testing"
 
 	"bitbucket.org/creachadair/stringset"
 	aepapi "buf.build/gen/go/aep/api/protocolbuffers/go/aep/api"
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/google/go-cmp/cmp"
 	apb "google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/proto"
 )
 
 func TestGetFieldBehavior(t *testing.T) {
-	fd := testutils.ParseProto3String(t, `
+	fd := ruletest.ParseProto3String(t, `
 		import "aep/api/field_info.proto";
 
 		message Book {
@@ -74,7 +74,7 @@ func TestGetMethodSignatures(t *testing.T) {
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/client.proto";
 				service Library {
 					rpc GetBook(GetBookRequest) returns (Book) {
@@ -93,7 +93,7 @@ func TestGetMethodSignatures(t *testing.T) {
 }
 
 func TestGetOperationInfo(t *testing.T) {
-	fd := testutils.ParseProto3String(t, `
+	fd := ruletest.ParseProto3String(t, `
 		import "google/longrunning/operations.proto";
 		service Library {
 			rpc WriteBook(WriteBookRequest) returns (google.longrunning.Operation) {
@@ -115,7 +115,7 @@ func TestGetOperationInfo(t *testing.T) {
 }
 
 func TestGetOperationInfoNone(t *testing.T) {
-	fd := testutils.ParseProto3String(t, `
+	fd := ruletest.ParseProto3String(t, `
 		service Library {
 			rpc GetBook(GetBookRequest) returns (Book);
 		}
@@ -140,7 +140,7 @@ func TestGetOperationInfoResponseType(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			fd := testutils.ParseProto3Tmpl(t, `
+			fd := ruletest.ParseProto3Tmpl(t, `
 				import "google/longrunning/operations.proto";
 				service Library {
 					rpc WriteBook(WriteBookRequest) returns (google.longrunning.Operation) {
@@ -183,7 +183,7 @@ func TestGetOperationInfoMetadataType(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			fd := testutils.ParseProto3Tmpl(t, `
+			fd := ruletest.ParseProto3Tmpl(t, `
 				import "google/longrunning/operations.proto";
 				service Library {
 					rpc WriteBook(WriteBookRequest) returns (google.longrunning.Operation) {
@@ -216,7 +216,7 @@ func TestGetOperationInfoMetadataType(t *testing.T) {
 
 func TestGetResource(t *testing.T) {
 	t.Run("Present", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
 			message Book {
 				option (aep.api.resource) = {
@@ -234,7 +234,7 @@ func TestGetResource(t *testing.T) {
 		}
 	})
 	t.Run("Absent", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, "message Book {}")
+		f := ruletest.ParseProto3String(t, "message Book {}")
 		if got := GetResource(f.GetMessageTypes()[0]); got != nil {
 			t.Errorf(`Got "%v", expected nil.`, got)
 		}
@@ -248,7 +248,7 @@ func TestGetResource(t *testing.T) {
 
 func TestGetResourceDefinition(t *testing.T) {
 	t.Run("Zero", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
 		`)
 		if got := GetResourceDefinitions(f); got != nil {
@@ -256,7 +256,7 @@ func TestGetResourceDefinition(t *testing.T) {
 		}
 	})
 	t.Run("One", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
 			import "google/api/resource.proto";
 			option (google.api.resource_definition) = {
@@ -272,7 +272,7 @@ func TestGetResourceDefinition(t *testing.T) {
 		}
 	})
 	t.Run("Two", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
 			import "google/api/resource.proto";
 			option (google.api.resource_definition) = {
@@ -297,7 +297,7 @@ func TestGetResourceDefinition(t *testing.T) {
 
 func TestGetResourceReference(t *testing.T) {
 	t.Run("Present", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
 			import "aep/api/field_info.proto";
 			message GetBookRequest {
@@ -311,7 +311,7 @@ func TestGetResourceReference(t *testing.T) {
 		}
 	})
 	t.Run("Absent", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, "message GetBookRequest { string name = 1; }")
+		f := ruletest.ParseProto3String(t, "message GetBookRequest { string name = 1; }")
 		if got := GetResourceReference(f.GetMessageTypes()[0].GetFields()[0]); got != nil {
 			t.Errorf(`Got "%v", expected nil`, got)
 		}
@@ -319,7 +319,7 @@ func TestGetResourceReference(t *testing.T) {
 }
 
 func TestFindResource(t *testing.T) {
-	files := testutils.ParseProtoStrings(t, map[string]string{
+	files := ruletest.ParseProtoStrings(t, map[string]string{
 		"book.proto": `
 			syntax = "proto3";
 			package test;
@@ -380,7 +380,7 @@ func TestFindResource(t *testing.T) {
 }
 
 func TestFindResourceMessage(t *testing.T) {
-	files := testutils.ParseProtoStrings(t, map[string]string{
+	files := ruletest.ParseProtoStrings(t, map[string]string{
 		"book.proto": `
 			syntax = "proto3";
 			package test;
@@ -490,7 +490,7 @@ func TestGetOutputOrLROResponseMessage(t *testing.T) {
 		`, ""},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/longrunning/operations.proto";
 				import "google/protobuf/field_mask.proto";
@@ -563,7 +563,7 @@ func TestFindResourceChildren(t *testing.T) {
 			"publishers/{publisher}/books/{book}/editions/{edition}",
 		},
 	}
-	files := testutils.ParseProtoStrings(t, map[string]string{
+	files := ruletest.ParseProtoStrings(t, map[string]string{
 		"book.proto": `
 			syntax = "proto3";
 			package test;
@@ -643,7 +643,7 @@ func TestHasFieldInfo(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 			import "google/api/field_info.proto";
 
 			message CreateBookRequest {
@@ -674,7 +674,7 @@ func TestGetFieldInfo(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 			import "google/api/field_info.proto";
 
 			message CreateBookRequest {
@@ -707,7 +707,7 @@ func TestHasFormat(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 			import "google/api/field_info.proto";
 
 			message CreateBookRequest {
@@ -741,7 +741,7 @@ func TestGetFormat(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 			import "google/api/field_info.proto";
 
 			message CreateBookRequest {
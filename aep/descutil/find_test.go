@@ -12,17 +12,17 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestFindMessage(t *testing.T) {
-	files := testutils.ParseProtoStrings(t, map[string]string{
+	files := ruletest.ParseProtoStrings(t, map[string]string{
 		"a.proto": `
 			package test;
 			message Book {}
@@ -46,7 +46,7 @@ func TestFindMessage(t *testing.T) {
 }
 
 func TestFindFieldDotNotation(t *testing.T) {
-	file := testutils.ParseProto3String(t, `
+	file := ruletest.ParseProto3String(t, `
 		package test;
 
 		message CreateBookRequest {
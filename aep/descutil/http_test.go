@@ -12,13 +12,13 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/google/go-cmp/cmp"
 	apb "google.golang.org/genproto/googleapis/api/annotations"
 )
@@ -26,7 +26,7 @@ import (
 func TestGetHTTPRules(t *testing.T) {
 	for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
 		t.Run(method, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc FrobBook(FrobBookRequest) returns (FrobBookResponse) {
@@ -62,7 +62,7 @@ func TestGetHTTPRules(t *testing.T) {
 }
 
 func TestGetHTTPRulesEmpty(t *testing.T) {
-	file := testutils.ParseProto3String(t, `
+	file := ruletest.ParseProto3String(t, `
 		import "google/api/annotations.proto";
 		service Library {
 			rpc FrobBook(FrobBookRequest) returns (FrobBookResponse);
@@ -83,7 +83,7 @@ func TestParseRuleEmpty(t *testing.T) {
 }
 
 func TestGetHTTPRulesCustom(t *testing.T) {
-	file := testutils.ParseProto3String(t, `
+	file := ruletest.ParseProto3String(t, `
 		import "google/api/annotations.proto";
 		service Library {
 			rpc FrobBook(FrobBookRequest) returns (FrobBookResponse) {
@@ -158,7 +158,7 @@ func TestHasHTTPRules(t *testing.T) {
 		{"no_rule", ""},
 	} {
 		t.Run(tst.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 
 				service Foo {
@@ -11,12 +11,12 @@
 // WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 // See the License for the specific language governing permissions and
 // limitations under the License.
-package utils
+package descutil
 
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -32,7 +32,7 @@ func TestListResponseResourceName(t *testing.T) {
 		{"InvalidNotList", "WriteBook", ""},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				message {{.RPC}}Response {}
 			`, test)
 			m := file.GetMessageTypes()[0]
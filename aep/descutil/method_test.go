@@ -11,12 +11,12 @@
 // WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 // See the License for the specific language governing permissions and
 // limitations under the License.
-package utils
+package descutil
 
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestIsCreateMethod(t *testing.T) {
@@ -33,7 +33,7 @@ func TestIsCreateMethod(t *testing.T) {
 		`, false},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/protobuf/field_mask.proto";
 				service Foo {
@@ -83,7 +83,7 @@ func TestIsUpdateMethod(t *testing.T) {
 		`, false},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/protobuf/field_mask.proto";
 				service Foo {
@@ -132,7 +132,7 @@ func TestIsListMethod(t *testing.T) {
 		`, false},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/protobuf/field_mask.proto";
 				service Foo {
@@ -213,7 +213,7 @@ func TestIsLegacyListRevisionsMethod(t *testing.T) {
 		`, false},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/resource.proto";
 				import "google/protobuf/field_mask.proto";
@@ -277,7 +277,7 @@ func TestGetListResourceMessage(t *testing.T) {
 		`, ""},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/protobuf/field_mask.proto";
 				service Foo {
@@ -12,13 +12,13 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 import (
 	"testing"
 
 	aepapi "buf.build/gen/go/aep/api/protocolbuffers/go/aep/api"
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestGetResourceSingular(t *testing.T) {
@@ -127,7 +127,7 @@ func TestIsResourceRevision(t *testing.T) {
 			want:     false,
 		},
 	} {
-		f := testutils.ParseProto3Tmpl(t, `
+		f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			message {{.Message}} {
 				{{.Resource}}
@@ -12,18 +12,18 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package utils
+package descutil
 
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestGetTypeName(t *testing.T) {
 	for _, ty := range []string{"int32", "int64", "string", "bytes", "google.protobuf.Timestamp", "Format"} {
 		t.Run(ty, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/protobuf/timestamp.proto";
 				message Book {
 					{{.Type}} field = 1;
@@ -0,0 +1,18 @@
+// Package ruletest provides helpers for testing rules: parsing proto
+// sources, possibly from templates, into descriptors, and comparing the
+// problems rules return with the expected ones.
+//
+// A typical test of a rule looks like this:
+//
+//	func TestMyRule(t *testing.T) {
+//	  f := ruletest.ParseProto3Tmpl(t, `
+//	    message {{.Name}} {}
+//	  `, struct{ Name string }{"Book"})
+//	  want := ruletest.Problems{{Descriptor: f.GetMessageTypes()[0]}}
+//	  if diff := want.Diff(myRule.Lint(f)); diff != "" {
+//	    t.Error(diff)
+//	  }
+//	}
+//
+// Like package descutil, the package follows the versioning of the module.
+package ruletest
@@ -0,0 +1,336 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// The buf configuration files.
+const (
+	bufModuleFile    = "buf.yaml"
+	bufWorkspaceFile = "buf.work.yaml"
+	bufLockFile      = "buf.lock"
+)
+
+// bufWorkspace is a set of buf modules, which can import the files of each
+// other and of their dependencies, as buf builds them.
+type bufWorkspace struct {
+	modules []*bufModule
+	// The directories of the dependencies in the buf module cache.
+	deps []string
+}
+
+// bufModule is a directory of proto files, which are named relative to it.
+type bufModule struct {
+	root string
+	// The directories and files within the module that buf does not build.
+	excludes []string
+}
+
+// bufConfig holds the parts of buf.yaml and buf.work.yaml files, in any
+// version, that determine the files of the modules.
+type bufConfig struct {
+	Version string `yaml:"version"`
+	// The module directories of a buf.work.yaml file.
+	Directories []string `yaml:"directories"`
+	// The excludes of a v1 buf.yaml file, relative to the module.
+	Build struct {
+		Excludes []string `yaml:"excludes"`
+	} `yaml:"build"`
+	// The modules of a v2 buf.yaml file, relative to the workspace.
+	Modules []struct {
+		Path     string   `yaml:"path"`
+		Excludes []string `yaml:"excludes"`
+	} `yaml:"modules"`
+}
+
+// bufLock holds the pinned dependencies of a buf.lock file, in any version.
+type bufLock struct {
+	Deps []struct {
+		// The module name, as of v2.
+		Name string `yaml:"name"`
+		// The module name, before v2.
+		Remote     string `yaml:"remote"`
+		Owner      string `yaml:"owner"`
+		Repository string `yaml:"repository"`
+		Commit     string `yaml:"commit"`
+		Digest     string `yaml:"digest"`
+	} `yaml:"deps"`
+}
+
+// bufWorkspaces finds the buf workspaces of directories, reading every
+// configuration once.
+type bufWorkspaces struct {
+	// The buf module cache, which is read but never written to.
+	cacheDir string
+	// The workspace of every directory looked up, by absolute path, which
+	// is nil for the directories outside of any workspace.
+	byDir map[string]*bufWorkspace
+	// The workspaces, by the absolute path of their configuration file.
+	byConfig map[string]*bufWorkspace
+	// Where to write warnings about dependencies missing from the cache.
+	warnings io.Writer
+}
+
+func newBufWorkspaces() *bufWorkspaces {
+	return &bufWorkspaces{
+		cacheDir: bufCacheDir(),
+		warnings: os.Stderr,
+		byDir:    map[string]*bufWorkspace{},
+		byConfig: map[string]*bufWorkspace{},
+	}
+}
+
+// find returns the workspace of a directory, given by absolute path, or
+// nil if there is none. The workspace is the one of the nearest buf.yaml
+// file at or above the directory if it is a v2 file; else the one of the
+// nearest buf.work.yaml file; else the module of a v1 buf.yaml file.
+func (w *bufWorkspaces) find(dir string) (*bufWorkspace, error) {
+	if ws, ok := w.byDir[dir]; ok {
+		return ws, nil
+	}
+	var moduleDir, workDir string
+	for d := dir; moduleDir == "" || workDir == ""; d = filepath.Dir(d) {
+		if moduleDir == "" && isFile(filepath.Join(d, bufModuleFile)) {
+			moduleDir = d
+		}
+		if workDir == "" && isFile(filepath.Join(d, bufWorkspaceFile)) {
+			workDir = d
+		}
+		if filepath.Dir(d) == d {
+			break
+		}
+	}
+
+	var ws *bufWorkspace
+	var err error
+	switch {
+	case moduleDir != "":
+		var cfg *bufConfig
+		if cfg, err = readBufConfig(filepath.Join(moduleDir, bufModuleFile)); err != nil {
+			return nil, err
+		}
+		switch {
+		case cfg.Version == "v2":
+			ws, err = w.load(filepath.Join(moduleDir, bufModuleFile), w.loadV2)
+		case workDir != "":
+			ws, err = w.load(filepath.Join(workDir, bufWorkspaceFile), w.loadWork)
+		default:
+			ws, err = w.load(filepath.Join(moduleDir, bufModuleFile), w.loadV1)
+		}
+	case workDir != "":
+		ws, err = w.load(filepath.Join(workDir, bufWorkspaceFile), w.loadWork)
+	}
+	if err != nil {
+		return nil, err
+	}
+	w.byDir[dir] = ws
+	return ws, nil
+}
+
+// load returns the workspace of a configuration file, loading it the first
+// time.
+func (w *bufWorkspaces) load(path string, load func(path string) (*bufWorkspace, error)) (*bufWorkspace, error) {
+	if ws, ok := w.byConfig[path]; ok {
+		return ws, nil
+	}
+	ws, err := load(path)
+	if err != nil {
+		return nil, err
+	}
+	w.byConfig[path] = ws
+	return ws, nil
+}
+
+// loadV1 loads a v1 buf.yaml file, which is a workspace of a single module.
+func (w *bufWorkspaces) loadV1(path string) (*bufWorkspace, error) {
+	ws := &bufWorkspace{}
+	if err := w.addV1Module(ws, filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// loadWork loads a buf.work.yaml file, whose directories are modules with
+// optional v1 buf.yaml files.
+func (w *bufWorkspaces) loadWork(path string) (*bufWorkspace, error) {
+	cfg, err := readBufConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	ws := &bufWorkspace{}
+	for _, dir := range cfg.Directories {
+		if err := w.addV1Module(ws, filepath.Join(filepath.Dir(path), dir)); err != nil {
+			return nil, err
+		}
+	}
+	return ws, nil
+}
+
+// addV1Module adds a module, along with the dependencies in its buf.lock
+// file, to a workspace.
+func (w *bufWorkspaces) addV1Module(ws *bufWorkspace, root string) error {
+	m := &bufModule{root: root}
+	if path := filepath.Join(root, bufModuleFile); isFile(path) {
+		cfg, err := readBufConfig(path)
+		if err != nil {
+			return err
+		}
+		for _, exclude := range cfg.Build.Excludes {
+			m.excludes = append(m.excludes, filepath.Join(root, exclude))
+		}
+	}
+	ws.modules = append(ws.modules, m)
+	return w.addDeps(ws, filepath.Join(root, bufLockFile))
+}
+
+// loadV2 loads a v2 buf.yaml file, whose modules and excludes are relative
+// to its directory, and default to the directory itself.
+func (w *bufWorkspaces) loadV2(path string) (*bufWorkspace, error) {
+	cfg, err := readBufConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(path)
+	ws := &bufWorkspace{}
+	if len(cfg.Modules) == 0 {
+		ws.modules = []*bufModule{{root: dir}}
+	}
+	for _, module := range cfg.Modules {
+		m := &bufModule{root: filepath.Join(dir, module.Path)}
+		for _, exclude := range module.Excludes {
+			m.excludes = append(m.excludes, filepath.Join(dir, exclude))
+		}
+		ws.modules = append(ws.modules, m)
+	}
+	if err := w.addDeps(ws, filepath.Join(dir, bufLockFile)); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// addDeps adds the dependencies pinned by a buf.lock file, if it exists, to
+// a workspace. The dependencies are looked up in the buf module cache, where
+// buf puts them when building the workspace; they are never downloaded.
+// Missing ones are skipped with a warning, since the files to lint may not
+// import them, and the parser reports the imports which cannot be found.
+func (w *bufWorkspaces) addDeps(ws *bufWorkspace, path string) error {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var lock bufLock
+	if err := yaml.Unmarshal(content, &lock); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for _, dep := range lock.Deps {
+		name := dep.Name
+		if name == "" {
+			name = strings.Join([]string{dep.Remote, dep.Owner, dep.Repository}, "/")
+		}
+		dir := w.cachedModule(name, dep.Commit, dep.Digest)
+		if dir == "" {
+			fmt.Fprintf(w.warnings, "warning: %s: dependency %s:%s is not in the buf module cache %s; run \"buf dep update\" to download it\n", path, name, dep.Commit, w.cacheDir)
+			continue
+		}
+		if !slices.Contains(ws.deps, dir) {
+			ws.deps = append(ws.deps, dir)
+		}
+	}
+	return nil
+}
+
+// cachedModule returns the directory of the files of a module commit in the
+// buf module cache, or an empty string if it is not there. Both the current
+// layout of the cache and the one of buf versions before 1.32 are searched.
+func (w *bufWorkspaces) cachedModule(name, commit, digest string) string {
+	digestTypes := []string{"b5", "shake256"}
+	if digestType, _, ok := strings.Cut(digest, ":"); ok {
+		digestTypes = []string{digestType}
+	}
+	var dirs []string
+	for _, digestType := range digestTypes {
+		dirs = append(dirs, filepath.Join(w.cacheDir, "v3", "modules", digestType, filepath.FromSlash(name), commit, "files"))
+	}
+	dirs = append(dirs, filepath.Join(w.cacheDir, "v1", "module", "data", filepath.FromSlash(name), commit))
+	for _, dir := range dirs {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir
+		}
+	}
+	return ""
+}
+
+// module returns the module of a file, given by absolute path, or nil if it
+// is not within any.
+func (ws *bufWorkspace) module(path string) *bufModule {
+	for _, m := range ws.modules {
+		if within(m.root, path) {
+			return m
+		}
+	}
+	return nil
+}
+
+// builds returns whether buf builds a file of the workspace, given by
+// absolute path: that is, whether it is within a module and not excluded.
+func (ws *bufWorkspace) builds(path string) bool {
+	m := ws.module(path)
+	if m == nil {
+		return false
+	}
+	for _, exclude := range m.excludes {
+		if path == exclude || within(exclude, path) {
+			return false
+		}
+	}
+	return true
+}
+
+// bufCacheDir returns the buf cache directory, as buf determines it.
+func bufCacheDir() string {
+	if dir := os.Getenv("BUF_CACHE_DIR"); dir != "" {
+		return dir
+	}
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("LOCALAPPDATA"), "buf")
+	}
+	if dir := os.Getenv("XDG_CACHE_HOME"); dir != "" {
+		return filepath.Join(dir, "buf")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".cache", "buf")
+}
+
+func readBufConfig(path string) (*bufConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &bufConfig{}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// within returns whether a path is within a directory.
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && filepath.IsLocal(rel)
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
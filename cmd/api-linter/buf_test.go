@@ -0,0 +1,232 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandTargets_BufWorkspaces(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("BUF_CACHE_DIR", filepath.Join(dir, "cache"))
+	files := map[string]string{
+		// A v2 workspace, with a dependency in the current cache layout.
+		"v2/buf.yaml": `version: v2
+modules:
+  - path: proto
+    excludes:
+      - proto/gen
+  - path: third_party
+deps:
+  - buf.build/acme/common
+`,
+		"v2/buf.lock": `version: v2
+deps:
+  - name: buf.build/acme/common
+    commit: 0123456789abcdef
+    digest: b5:0123
+`,
+		"v2/proto/acme/v1/a.proto":     "",
+		"v2/proto/gen/gen.proto":       "",
+		"v2/third_party/dep/dep.proto": "",
+		"v2/scripts/script.proto":      "",
+		"cache/v3/modules/b5/buf.build/acme/common/0123456789abcdef/files/c": "",
+
+		// A v1 workspace, with a dependency in the former cache layout.
+		"work/buf.work.yaml": "version: v1\ndirectories:\n  - a\n  - b\n",
+		"work/a/buf.yaml":    "version: v1\nbuild:\n  excludes:\n    - old\n",
+		"work/a/buf.lock": `version: v1
+deps:
+  - remote: buf.build
+    owner: acme
+    repository: legacy
+    commit: fedcba9876543210
+`,
+		"work/a/acme/a.proto":  "",
+		"work/a/old/old.proto": "",
+		"work/b/acme/b.proto":  "",
+		"cache/v1/module/data/buf.build/acme/legacy/fedcba9876543210/l": "",
+
+		// A v1 module.
+		"v1/buf.yaml":       "version: v1\n",
+		"v1/acme/one.proto": "",
+
+		// A module with an invalid configuration.
+		"invalid/buf.yaml":       "version: [",
+		"invalid/acme/one.proto": "",
+	}
+	for path, content := range files {
+		if err := writeFile(path, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The cache is within the current directory, so its paths are relative.
+	cache := func(path string) string {
+		return filepath.Join("cache", filepath.FromSlash(path))
+	}
+
+	tests := []struct {
+		name            string
+		targets         []string
+		wantFiles       []string
+		wantImportPaths []string
+	}{
+		{
+			name:    "V2",
+			targets: []string{"v2/..."},
+			wantFiles: []string{
+				filepath.FromSlash("v2/proto/acme/v1/a.proto"),
+				filepath.FromSlash("v2/third_party/dep/dep.proto"),
+			},
+			wantImportPaths: []string{
+				filepath.FromSlash("v2/proto"),
+				filepath.FromSlash("v2/third_party"),
+				cache("v3/modules/b5/buf.build/acme/common/0123456789abcdef/files"),
+			},
+		},
+		{
+			name:      "V2ExplicitFile",
+			targets:   []string{"v2/scripts/script.proto"},
+			wantFiles: []string{filepath.FromSlash("v2/scripts/script.proto")},
+			wantImportPaths: []string{
+				filepath.FromSlash("v2/proto"),
+				filepath.FromSlash("v2/third_party"),
+				cache("v3/modules/b5/buf.build/acme/common/0123456789abcdef/files"),
+			},
+		},
+		{
+			name:    "Work",
+			targets: []string{"work"},
+			wantFiles: []string{
+				filepath.FromSlash("work/a/acme/a.proto"),
+				filepath.FromSlash("work/b/acme/b.proto"),
+			},
+			wantImportPaths: []string{
+				filepath.FromSlash("work/a"),
+				filepath.FromSlash("work/b"),
+				cache("v1/module/data/buf.build/acme/legacy/fedcba9876543210"),
+			},
+		},
+		{
+			name:            "V1",
+			targets:         []string{"v1/..."},
+			wantFiles:       []string{filepath.FromSlash("v1/acme/one.proto")},
+			wantImportPaths: []string{"v1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files, importPaths, err := expandTargets(test.targets, nil, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.wantFiles, files); diff != "" {
+				t.Errorf("expandTargets() files mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantImportPaths, importPaths); diff != "" {
+				t.Errorf("expandTargets() import paths mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		t.Setenv("BUF_CACHE_DIR", filepath.Join(dir, "empty"))
+		stderr := os.Stderr
+		defer func() { os.Stderr = stderr }()
+		var err error
+		if os.Stderr, err = os.Create(filepath.Join(dir, "stderr")); err != nil {
+			t.Fatal(err)
+		}
+		_, importPaths, err := expandTargets([]string{"v2/..."}, nil, false)
+		if err != nil {
+			t.Fatalf("expandTargets() got error %v for a missing dependency, but want none", err)
+		}
+		want := []string{filepath.FromSlash("v2/proto"), filepath.FromSlash("v2/third_party")}
+		if diff := cmp.Diff(want, importPaths); diff != "" {
+			t.Errorf("expandTargets() import paths mismatch (-want +got):\n%s", diff)
+		}
+		os.Stderr.Close()
+		warnings, err := os.ReadFile(filepath.Join(dir, "stderr"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(warnings), "buf.build/acme/common") {
+			t.Errorf("expandTargets() warned %q, but want a warning for the missing dependency", warnings)
+		}
+	})
+
+	t.Run("ExplicitImports", func(t *testing.T) {
+		// The workspace of a file given with its import paths is not read.
+		files, importPaths, err := expandTargets([]string{"invalid/acme/one.proto"}, nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{filepath.FromSlash("invalid/acme/one.proto")}, files); diff != "" {
+			t.Errorf("expandTargets() files mismatch (-want +got):\n%s", diff)
+		}
+		if len(importPaths) != 0 {
+			t.Errorf("expandTargets() got import paths %v, but want none", importPaths)
+		}
+		if _, _, err := expandTargets([]string{"invalid/acme/one.proto"}, nil, false); err == nil {
+			t.Error("expandTargets() got no error for an invalid buf.yaml, but want one")
+		}
+	})
+}
+
+func TestLintBufWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("BUF_CACHE_DIR", filepath.Join(dir, "cache"))
+	files := map[string]string{
+		"buf.yaml": "version: v2\nmodules:\n  - path: proto\n",
+		"buf.lock": "version: v2\ndeps:\n  - name: buf.build/acme/common\n    commit: abc\n    digest: b5:abc\n",
+		"cache/v3/modules/b5/buf.build/acme/common/abc/files/acme/common/v1/book.proto": `syntax = "proto3";
+package acme.common.v1;
+message Book {}
+`,
+		"proto/acme/library/v1/shelf.proto": `syntax = "proto3";
+package acme.library.v1;
+message Shelf {}
+`,
+		"proto/acme/library/v1/library.proto": `syntax = "proto3";
+package acme.library.v1;
+import "acme/common/v1/book.proto";
+service Library {
+  rpc GetBook(acme.common.v1.Book) returns (acme.common.v1.Book);
+}
+`,
+	}
+	for path, content := range files {
+		if err := writeFile(path, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := runCLI([]string{"-o=out.yaml", "--set-exit-status", "./..."})
+	if !errors.Is(err, ExitForLintFailure) {
+		t.Fatalf("runCLI got error %v, but want %v", err, ExitForLintFailure)
+	}
+	out, err := os.ReadFile("out.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "file_path: acme/library/v1/library.proto"; !strings.Contains(string(out), want) {
+		t.Errorf("output %q does not contain %q", out, want)
+	}
+	if notWant := "book.proto"; strings.Contains(string(out), notWant) {
+		t.Errorf("output %q contains %q", out, notWant)
+	}
+
+	// Files which do not import missing dependencies can still be linted.
+	t.Run("EmptyCache", func(t *testing.T) {
+		t.Setenv("BUF_CACHE_DIR", filepath.Join(dir, "empty"))
+		if err := runCLI([]string{"-o=out.yaml", "proto/acme/library/v1/shelf.proto"}); err != nil {
+			t.Errorf("runCLI got error %v with an empty buf module cache, but want none", err)
+		}
+	})
+}
@@ -19,11 +19,13 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 
-	"github.com/aep-dev/api-linter/internal"
 	"github.com/aep-dev/api-linter/lint"
+	"github.com/aep-dev/api-linter/plugin"
+	"github.com/aep-dev/api-linter/rules"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/protoparse"
 	"github.com/spf13/pflag"
@@ -33,6 +35,7 @@ import (
 )
 
 type cli struct {
+	Command                   string
 	ConfigPath                string
 	FormatType                string
 	OutputPath                string
@@ -40,12 +43,25 @@ type cli struct {
 	VersionFlag               bool
 	ProtoImportPaths          []string
 	ProtoFiles                []string
+	ExcludePatterns           []string
 	ProtoDescPath             []string
+	DescriptorSetTargets      []string
 	EnabledRules              []string
 	DisabledRules             []string
 	ListRulesFlag             bool
+	ListRulesAEP              int
+	ListRulesType             string
 	DebugFlag                 bool
 	IgnoreCommentDisablesFlag bool
+	PrintConfigPath           string
+	ReportUnusedDisablesFlag  bool
+	SuppressionsPath          string
+	ReportStaleSuppressions   bool
+	PluginPaths               []string
+	RuleDocURLTemplate        string
+	DiffBase                  string
+	DiffPath                  string
+	DiffDescriptorsFlag       bool
 }
 
 // ExitForLintFailure indicates that a problem was found during linting.
@@ -53,7 +69,28 @@ type cli struct {
 //lint:ignore ST1012 modifying this variable name is a breaking change.
 var ExitForLintFailure = errors.New("found problems during linting")
 
+// ErrParseFailure indicates that some files fail to parse. Their errors
+// are in the output, and the other files are linted.
+var ErrParseFailure = errors.New("found errors parsing files")
+
+// Commands that can be given as the first argument, instead of linting.
+const (
+	explainCommand           = "explain"
+	explainEnablementCommand = "explain-enablement"
+)
+
+var commands = map[string]bool{
+	explainCommand:           true,
+	explainEnablementCommand: true,
+}
+
 func newCli(args []string) *cli {
+	// Determine the command, if any.
+	var command string
+	if len(args) > 0 && commands[args[0]] {
+		command, args = args[0], args[1:]
+	}
+
 	// Define flag variables.
 	var cfgFlag string
 	var fmtFlag string
@@ -62,26 +99,52 @@ func newCli(args []string) *cli {
 	var versionFlag bool
 	var protoImportFlag []string
 	var protoDescFlag []string
+	var descSetTargetFlag []string
+	var excludeFlag []string
 	var ruleEnableFlag []string
 	var ruleDisableFlag []string
 	var listRulesFlag bool
+	var listRulesAEPFlag int
+	var listRulesTypeFlag string
 	var debugFlag bool
 	var ignoreCommentDisablesFlag bool
+	var printConfigFlag string
+	var reportUnusedDisablesFlag bool
+	var suppressionsFlag string
+	var reportStaleSuppressionsFlag bool
+	var pluginFlag []string
+	var ruleDocURLTemplateFlag string
+	var diffBaseFlag string
+	var diffFileFlag string
+	var diffDescriptorsFlag bool
 
 	// Register flag variables.
 	fs := pflag.NewFlagSet("api-linter", pflag.ExitOnError)
 	fs.StringVar(&cfgFlag, "config", "", "The linter config file.")
 	fs.StringVar(&fmtFlag, "output-format", "", "The format of the linting results.\nSupported formats include \"yaml\", \"json\",\"github\" and \"summary\" table.\nYAML is the default.")
 	fs.StringVarP(&outFlag, "output-path", "o", "", "The output file path.\nIf not given, the linting results will be printed out to STDOUT.")
-	fs.BoolVar(&setExitStatusOnLintFailure, "set-exit-status", false, "Return exit status 1 when lint errors are found.")
+	fs.BoolVar(&setExitStatusOnLintFailure, "set-exit-status", false, "Return exit status 1 when lint errors are found.\nFiles that fail to parse always return a non-zero exit status.")
 	fs.BoolVar(&versionFlag, "version", false, "Print version and exit.")
 	fs.StringArrayVarP(&protoImportFlag, "proto-path", "I", nil, "The folder for searching proto imports.\nMay be specified multiple times; directories will be searched in order.\nThe current working directory is always used.")
 	fs.StringArrayVar(&protoDescFlag, "descriptor-set-in", nil, "The file containing a FileDescriptorSet for searching proto imports.\nMay be specified multiple times.")
+	fs.StringArrayVar(&descSetTargetFlag, "descriptor-set-target", nil, "The file containing a FileDescriptorSet to lint, such as the output of\n\"protoc --include_source_info -o\". Every file of the set is linted, except the\nimports given with --descriptor-set-in and the well-known protos. Positional\narguments ending in \".pb\" or \".binpb\" are linted the same way. May be\nspecified multiple times.")
+	fs.StringArrayVar(&excludeFlag, "exclude", nil, "A doublestar pattern, such as \"**/vendor/**\", of the files and directories\nnot to lint when expanding directories and globs, or of the files of descriptor\nsets not to lint. May be specified multiple times.")
 	fs.StringArrayVar(&ruleEnableFlag, "enable-rule", nil, "Enable a rule with the given name.\nMay be specified multiple times.")
 	fs.StringArrayVar(&ruleDisableFlag, "disable-rule", nil, "Disable a rule with the given name.\nMay be specified multiple times.")
 	fs.BoolVar(&listRulesFlag, "list-rules", false, "Print the rules and exit.  Honors the output-format flag.")
+	fs.IntVar(&listRulesAEPFlag, "aep", 0, "With --list-rules, only list the rules of the given AEP.")
+	fs.StringVar(&listRulesTypeFlag, "type", "", "With --list-rules, only list the rules of the given type:\n\"must\", \"should\" or \"may\".")
 	fs.BoolVar(&debugFlag, "debug", false, "Run in debug mode. Panics will print stack.")
 	fs.BoolVar(&ignoreCommentDisablesFlag, "ignore-comment-disables", false, "If set to true, disable comments will be ignored.\nThis is helpful when strict enforcement of AIPs are necessary and\nproto definitions should not be able to disable checks.")
+	fs.BoolVar(&reportUnusedDisablesFlag, "report-unused-disables", false, "Report disable comments which did not suppress any problem,\nor which refer to rules that do not exist.")
+	fs.StringVar(&suppressionsFlag, "suppressions", "", "The suppressions file, disabling rules on descriptors by their fully qualified names.\nDefaults to the nearest "+lint.SuppressionsFileName+" in the directory of each proto file or its parents, up to the repository root.")
+	fs.BoolVar(&reportStaleSuppressionsFlag, "report-stale-suppressions", false, "Report suppressions which do not match any descriptor in the linted files.")
+	fs.StringArrayVar(&pluginFlag, "plugin", nil, "A plugin executable providing additional rules.\nMay be specified multiple times.")
+	fs.StringVar(&ruleDocURLTemplateFlag, "rule-doc-url-template", "", "The template of the rule documentation URLs, such as \"https://aep.dev/{aep}\".\n\"{rule}\", \"{group}\", \"{aep}\" and \"{name}\" are replaced by the rule name and its parts.")
+	fs.StringVar(&diffBaseFlag, "diff-base", "", "Only report problems on the lines changed since the given git revision,\nin the git repository of the current directory.")
+	fs.StringVar(&diffFileFlag, "diff-file", "", "Only report problems on the lines changed by the unified diff in the given file,\nor in STDIN if \"-\". Paths in the diff are relative to the current directory.")
+	fs.BoolVar(&diffDescriptorsFlag, "diff-descriptors", false, "With --diff-base or --diff-file, also report problems on the descriptors\nwhose definitions overlap the changed lines.")
+	fs.StringVar(&printConfigFlag, "print-config", "", "Print the resolved configs that apply to the given proto file and exit.\nHonors the output-format flag.")
 
 	// Parse flags.
 	err := fs.Parse(args)
@@ -90,57 +153,238 @@ func newCli(args []string) *cli {
 	}
 
 	return &cli{
+		Command:                   command,
 		ConfigPath:                cfgFlag,
 		FormatType:                fmtFlag,
 		OutputPath:                outFlag,
 		ExitStatusOnLintFailure:   setExitStatusOnLintFailure,
 		ProtoImportPaths:          append(protoImportFlag, "."),
 		ProtoDescPath:             protoDescFlag,
+		DescriptorSetTargets:      descSetTargetFlag,
 		EnabledRules:              ruleEnableFlag,
 		DisabledRules:             ruleDisableFlag,
 		ProtoFiles:                fs.Args(),
+		ExcludePatterns:           excludeFlag,
 		VersionFlag:               versionFlag,
 		ListRulesFlag:             listRulesFlag,
+		ListRulesAEP:              listRulesAEPFlag,
+		ListRulesType:             listRulesTypeFlag,
 		DebugFlag:                 debugFlag,
 		IgnoreCommentDisablesFlag: ignoreCommentDisablesFlag,
+		PrintConfigPath:           printConfigFlag,
+		ReportUnusedDisablesFlag:  reportUnusedDisablesFlag,
+		SuppressionsPath:          suppressionsFlag,
+		ReportStaleSuppressions:   reportStaleSuppressionsFlag,
+		PluginPaths:               pluginFlag,
+		RuleDocURLTemplate:        ruleDocURLTemplateFlag,
+		DiffBase:                  diffBaseFlag,
+		DiffPath:                  diffFileFlag,
+		DiffDescriptorsFlag:       diffDescriptorsFlag,
 	}
 }
 
 func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
-	// Print version and exit if asked.
-	if c.VersionFlag {
-		fmt.Printf("api-linter %s\n", internal.Version)
-		return nil
+	// Read the explicitly given configs, which take precedence over any
+	// configs discovered next to the proto files.
+	rules, explicitConfigs, err := c.explicitConfigs(rules)
+	if err != nil {
+		return err
 	}
 
 	if c.ListRulesFlag {
-		return outputRules(c.FormatType)
+		return outputRules(rules, ruleFilter{AEP: c.ListRulesAEP, Type: c.ListRulesType}, c.RuleDocURLTemplate, c.FormatType)
+	}
+
+	if c.PrintConfigPath != "" {
+		return c.printConfig(rules, configs, explicitConfigs)
 	}
 
 	// Pre-check if there are files to lint.
-	if len(c.ProtoFiles) == 0 {
+	c.splitDescriptorSetTargets()
+	if len(c.ProtoFiles) == 0 && len(c.DescriptorSetTargets) == 0 {
 		return fmt.Errorf("no file to lint")
 	}
-	// Read linter config and append it to the default.
-	if c.ConfigPath != "" {
-		config, err := lint.ReadConfigsFromFile(c.ConfigPath)
+	if len(c.ProtoFiles) > 0 {
+		if err := c.expandTargets(); err != nil {
+			return err
+		}
+	}
+	diff, err := c.readDiff()
+	if err != nil {
+		return err
+	}
+	var fd []*desc.FileDescriptor
+	var parseResults []lint.Response
+	if len(c.ProtoFiles) > 0 {
+		if fd, parseResults, err = c.parseTargets(c.ProtoFiles...); err != nil {
+			return err
+		}
+	}
+	sets, err := c.loadDescriptorSetTargets()
+	if err != nil {
+		return err
+	}
+	fd = append(fd, sets...)
+
+	// Create a linter for every set of discovered configs, and lint each
+	// file descriptor with the linter for its directory.
+	linters := map[string]*dirLinter{}
+	suppressions := suppressionsFiles{}
+	linterFor := func(path string) (*dirLinter, error) {
+		dir := ""
+		if path != "" {
+			dir = filepath.Dir(path)
+		}
+		if l, ok := linters[dir]; ok {
+			return l, nil
+		}
+		l, err := c.newLinter(rules, configs, explicitConfigs, suppressions, path)
+		if err != nil {
+			return nil, err
+		}
+		linters[dir] = l
+		return l, nil
+	}
+
+	// Parse errors can be disabled by the configs of their files, like rules.
+	var results []lint.Response
+	for _, r := range parseResults {
+		l, err := linterFor(findSourceFile(c.ProtoImportPaths, r.FilePath))
 		if err != nil {
 			return err
 		}
-		configs = append(configs, config...)
+		if l.configs.IsRuleEnabled(string(lint.ParseErrorRuleName), r.FilePath) {
+			results = append(results, r)
+		}
+	}
+	parseFailed := len(results) > 0
+
+	var ruleErrs []error
+	for _, f := range fd {
+		path := findSourceFile(c.ProtoImportPaths, f.GetName())
+		l, err := linterFor(path)
+		if err != nil {
+			return err
+		}
+		if l.suppressions != nil {
+			l.suppressions.files = append(l.suppressions.files, f)
+		}
+		// Rules that fail are reported after the results of the others.
+		resps, err := l.LintProtos(f)
+		if err != nil {
+			ruleErrs = append(ruleErrs, err)
+		}
+		if diff != nil {
+			for i := range resps {
+				resps[i] = diff.filter(resps[i], path, c.DiffDescriptorsFlag)
+			}
+		}
+		results = append(results, resps...)
 	}
-	// Add configs for the enabled rules.
-	configs = append(configs, lint.Config{
-		EnabledRules: c.EnabledRules,
-	})
-	// Add configs for the disabled rules.
-	configs = append(configs, lint.Config{
-		DisabledRules: c.DisabledRules,
-	})
+	if c.ReportStaleSuppressions {
+		results = append(results, suppressions.staleResponses()...)
+	}
+	lint.SortResponses(results)
+
+	// Determine the output for writing the results.
+	// Stdout is the default output.
+	w := os.Stdout
+	if c.OutputPath != "" {
+		var err error
+		w, err = os.Create(c.OutputPath)
+		if err != nil {
+			return err
+		}
+		defer w.Close()
+	}
+
+	// Determine the format for printing the results.
+	// YAML format is the default.
+	marshal := getOutputFormatFunc(c.FormatType)
+
+	// Print the results.
+	b, err := marshal(results)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(b); err != nil {
+		return err
+	}
+
+	// Files that fail to parse, and rules that fail, always fail the run, as
+	// they may hide problems.
+	if parseFailed {
+		ruleErrs = append(ruleErrs, ErrParseFailure)
+	}
+	if len(ruleErrs) > 0 {
+		return errors.Join(ruleErrs...)
+	}
+
+	// Return error on lint failure which subsequently
+	// exits with a non-zero status code
+	if c.ExitStatusOnLintFailure && anyProblems(results) {
+		return ExitForLintFailure
+	}
+
+	return nil
+}
+
+// withPlugins returns the rules along with the rules of the plugins.
+func (c *cli) withPlugins(rules lint.RuleRegistry) (lint.RuleRegistry, error) {
+	if len(c.PluginPaths) == 0 {
+		return rules, nil
+	}
+	all := copyRules(rules)
+	for _, path := range c.PluginPaths {
+		p, err := plugin.Load(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.Register(all); err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+// withCustomRules returns the rules along with the custom rules declared in
+// the configs.
+func withCustomRules(registry lint.RuleRegistry, configs lint.Configs) (lint.RuleRegistry, error) {
+	if len(configs.CustomRules()) == 0 {
+		return registry, nil
+	}
+	all := copyRules(registry)
+	if err := rules.AddCustomRules(all, configs); err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+func copyRules(registry lint.RuleRegistry) lint.RuleRegistry {
+	all := lint.NewRuleRegistry()
+	for name, rule := range registry {
+		all[name] = rule
+	}
+	return all
+}
+
+// parseFiles parses the given proto files into file descriptors.
+func (c *cli) parseFiles(files ...string) ([]*desc.FileDescriptor, error) {
+	// Resolve file absolute paths to relative ones.
+	protoFiles, err := protoparse.ResolveFilenames(c.ProtoImportPaths, files...)
+	if err != nil {
+		return nil, err
+	}
+	return c.parseResolvedFiles(protoFiles...)
+}
+
+// parseResolvedFiles parses the given proto files, whose names are relative
+// to the import paths, into file descriptors.
+func (c *cli) parseResolvedFiles(protoFiles ...string) ([]*desc.FileDescriptor, error) {
 	// Prepare proto import lookup.
 	fs, err := loadFileDescriptors(c.ProtoDescPath...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	lookupImport := func(name string) (*desc.FileDescriptor, error) {
 		if f, found := fs[name]; found {
@@ -148,7 +392,7 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 		}
 		return nil, fmt.Errorf("%q is not found", name)
 	}
-	var errorsWithPos []protoparse.ErrorWithPos
+	var errorsWithPos parseErrors
 	var lock sync.Mutex
 	// Parse proto files into `protoreflect` file descriptors.
 	p := protoparse.Parser{
@@ -164,66 +408,328 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 			return nil
 		},
 	}
-	// Resolve file absolute paths to relative ones.
-	protoFiles, err := protoparse.ResolveFilenames(c.ProtoImportPaths, c.ProtoFiles...)
-	if err != nil {
-		return err
-	}
 	fd, err := p.ParseFiles(protoFiles...)
 	if err != nil {
 		if err == protoparse.ErrInvalidSource {
 			if len(errorsWithPos) == 0 {
-				return errors.New("got protoparse.ErrInvalidSource but no ErrorWithPos errors")
-			}
-			// TODO: There's multiple ways to deal with this but this prints all the errors at least
-			errStrings := make([]string, len(errorsWithPos))
-			for i, errorWithPos := range errorsWithPos {
-				errStrings[i] = errorWithPos.Error()
+				return nil, errors.New("got protoparse.ErrInvalidSource but no ErrorWithPos errors")
 			}
-			return errors.New(strings.Join(errStrings, "\n"))
+			return nil, errorsWithPos
 		}
-		return err
+		return nil, err
 	}
+	return fd, nil
+}
 
-	// Create a linter to lint the file descriptors.
-	l := lint.New(rules, configs, lint.Debug(c.DebugFlag), lint.IgnoreCommentDisables(c.IgnoreCommentDisablesFlag))
-	results, err := l.LintProtos(fd...)
+// parseTargets parses the given proto files like parseFiles, except that
+// the errors of files that fail to parse are returned as responses, and the
+// files that parse are returned to be linted nonetheless.
+func (c *cli) parseTargets(files ...string) ([]*desc.FileDescriptor, []lint.Response, error) {
+	protoFiles, err := protoparse.ResolveFilenames(c.ProtoImportPaths, files...)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	fd, err := c.parseResolvedFiles(protoFiles...)
+	var errs parseErrors
+	if !errors.As(err, &errs) {
+		return fd, nil, err
 	}
 
-	// Determine the output for writing the results.
-	// Stdout is the default output.
-	w := os.Stdout
-	if c.OutputPath != "" {
+	// Parse again the files without errors of their own. Only if some of
+	// them import a file with errors, parse them one at a time to tell those
+	// that parse.
+	failed := map[string]bool{}
+	for _, e := range errs {
+		failed[e.GetPosition().Filename] = true
+	}
+	var rest []string
+	for _, file := range protoFiles {
+		if !failed[file] {
+			rest = append(rest, file)
+		}
+	}
+	if len(rest) == 0 {
+		return nil, errs.responses(), nil
+	}
+	fd, err = c.parseResolvedFiles(rest...)
+	if !errors.As(err, new(parseErrors)) {
+		if err != nil {
+			return nil, nil, err
+		}
+		return fd, errs.responses(), nil
+	}
+	fd = nil
+	for _, file := range rest {
+		fds, err := c.parseResolvedFiles(file)
+		var fileErrs parseErrors
+		if errors.As(err, &fileErrs) {
+			errs = append(errs, fileErrs...)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		fd = append(fd, fds...)
+	}
+	return fd, errs.responses(), nil
+}
+
+// parseErrors are the errors of proto files that fail to parse.
+type parseErrors []protoparse.ErrorWithPos
+
+func (errs parseErrors) Error() string {
+	errStrings := make([]string, len(errs))
+	for i, err := range errs {
+		errStrings[i] = err.Error()
+	}
+	return strings.Join(errStrings, "\n")
+}
+
+// responses returns the errors as problems of the files they are in, with
+// the lint.ParseErrorRuleName rule ID.
+//
+// An error in an import is reported once, however many files import it.
+func (errs parseErrors) responses() []lint.Response {
+	var responses []lint.Response
+	index := map[string]int{}
+	seen := map[string]bool{}
+	for _, err := range errs {
+		if seen[err.Error()] {
+			continue
+		}
+		seen[err.Error()] = true
+		pos := err.GetPosition()
+		i, ok := index[pos.Filename]
+		if !ok {
+			i = len(responses)
+			index[pos.Filename] = i
+			responses = append(responses, lint.Response{FilePath: pos.Filename})
+		}
+		problem := lint.NewParseErrorProblem(pos.Filename, pos.Line, pos.Col, err.Unwrap().Error())
+		responses[i].Problems = append(responses[i].Problems, problem)
+	}
+	return responses
+}
+
+// explicitConfigs returns the configs given with the config flag and the
+// rule flags, validated against the given rules and the custom rules they
+// declare, along with all of those rules.
+func (c *cli) explicitConfigs(rules lint.RuleRegistry) (lint.RuleRegistry, lint.Configs, error) {
+	configs := lint.Configs{}
+	// Read linter config.
+	if c.ConfigPath != "" {
+		var config lint.Configs
 		var err error
-		w, err = os.Create(c.OutputPath)
+		rules, config, err = readValidConfigs(rules, c.ConfigPath)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
-		defer w.Close()
+		configs = append(configs, config...)
+	}
+	// Add configs for the enabled and disabled rules.
+	flagConfigs := lint.Configs{}
+	if len(c.EnabledRules) > 0 {
+		flagConfigs = append(flagConfigs, lint.Config{
+			EnabledRules: c.EnabledRules,
+		})
+	}
+	if len(c.DisabledRules) > 0 {
+		flagConfigs = append(flagConfigs, lint.Config{
+			DisabledRules: c.DisabledRules,
+		})
+	}
+	if err := flagConfigs.Validate(rules); err != nil {
+		return nil, nil, fmt.Errorf("invalid rule flags:\n%w", err)
 	}
+	return rules, append(configs, flagConfigs...), nil
+}
 
-	// Determine the format for printing the results.
-	// YAML format is the default.
-	marshal := getOutputFormatFunc(c.FormatType)
+// readValidConfigs reads the configs from the given file and validates them
+// against the given rules and the custom rules they declare, which it returns
+// along with the given rules.
+func readValidConfigs(rules lint.RuleRegistry, path string) (lint.RuleRegistry, lint.Configs, error) {
+	configs, err := lint.ReadConfigsFromFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rules, err = withCustomRules(rules, configs); err != nil {
+		return nil, nil, fmt.Errorf("invalid config %s:\n%w", path, err)
+	}
+	if err := configs.Validate(rules); err != nil {
+		return nil, nil, fmt.Errorf("invalid config %s:\n%w", path, err)
+	}
+	return rules, configs, nil
+}
 
-	// Print the results.
-	b, err := marshal(results)
+// discoverConfigs returns the default configs, followed by the configs
+// discovered for the proto file at the given path and then the explicit
+// configs, along with the rules and the custom rules of the discovered
+// configs.
+//
+// An empty path means that no configs are discovered. The config given with
+// the config flag is not read again if it is discovered.
+func (c *cli) discoverConfigs(rules lint.RuleRegistry, defaults, explicit lint.Configs, path string) (lint.RuleRegistry, lint.Configs, error) {
+	configs := append(lint.Configs{}, defaults...)
+	if path != "" {
+		files, err := lint.FindConfigFiles(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, f := range files {
+			if c.isConfigPath(f) {
+				continue
+			}
+			var discovered lint.Configs
+			rules, discovered, err = readValidConfigs(rules, f)
+			if err != nil {
+				return nil, nil, err
+			}
+			configs = append(configs, discovered...)
+		}
+	}
+	return rules, append(configs, explicit...), nil
+}
+
+// isConfigPath returns true if the path is the config given with the config
+// flag.
+func (c *cli) isConfigPath(path string) bool {
+	if c.ConfigPath == "" {
+		return false
+	}
+	a, errA := filepath.Abs(path)
+	b, errB := filepath.Abs(c.ConfigPath)
+	return errA == nil && errB == nil && a == b
+}
+
+// suppressionsPath returns the path of the suppressions file for the proto
+// file at the given path: the one given with the suppressions flag, or else
+// the nearest one discovered like config files, if any. As for configs, an
+// empty path means that no suppressions file is discovered.
+func (c *cli) suppressionsPath(path string) (string, error) {
+	if c.SuppressionsPath != "" || path == "" {
+		return c.SuppressionsPath, nil
+	}
+	return lint.FindSuppressionsFile(path)
+}
+
+// suppressionsFiles reads every suppressions file once, and records the
+// files linted with each of them to report their stale suppressions.
+type suppressionsFiles map[string]*suppressionsFile
+
+type suppressionsFile struct {
+	suppressions lint.Suppressions
+	// The configs of the first files linted with the suppressions, which
+	// enable or disable the stale suppression problems.
+	configs lint.Configs
+	files   []*desc.FileDescriptor
+}
+
+// read returns the suppressions file at the given path, if any, validated
+// against the rules of the files linted with it.
+func (s suppressionsFiles) read(path string, rules lint.RuleRegistry, configs lint.Configs) (*suppressionsFile, error) {
+	if path == "" {
+		return nil, nil
+	}
+	sf, ok := s[path]
+	if !ok {
+		sups, err := lint.ReadSuppressionsFromFile(path)
+		if err != nil {
+			return nil, err
+		}
+		sf = &suppressionsFile{suppressions: sups, configs: configs}
+		s[path] = sf
+	}
+	if err := sf.suppressions.Validate(rules); err != nil {
+		return nil, fmt.Errorf("invalid suppressions %s:\n%w", path, err)
+	}
+	return sf, nil
+}
+
+// staleResponses returns a response for every suppressions file with
+// suppressions that do not match any of the files linted with it.
+func (s suppressionsFiles) staleResponses() []lint.Response {
+	var responses []lint.Response
+	for path, sf := range s {
+		if !sf.configs.IsRuleEnabled(string(lint.StaleSuppressionRuleName), "") {
+			continue
+		}
+		if problems := sf.suppressions.StaleProblems(sf.files...); len(problems) > 0 {
+			responses = append(responses, lint.Response{FilePath: path, Problems: problems})
+		}
+	}
+	return responses
+}
+
+// dirLinter is the linter of the proto files in a directory, along with
+// their configs and their suppressions file, if any.
+type dirLinter struct {
+	*lint.Linter
+	configs      lint.Configs
+	suppressions *suppressionsFile
+}
+
+// newLinter creates a linter using the configs and the suppressions that
+// apply to the proto file at the given path.
+func (c *cli) newLinter(rules lint.RuleRegistry, defaults, explicit lint.Configs, suppressions suppressionsFiles, path string) (*dirLinter, error) {
+	rules, configs, err := c.discoverConfigs(rules, defaults, explicit, path)
+	if err != nil {
+		return nil, err
+	}
+	supPath, err := c.suppressionsPath(path)
+	if err != nil {
+		return nil, err
+	}
+	sf, err := suppressions.read(supPath, rules, configs)
 	if err != nil {
+		return nil, err
+	}
+	var sups lint.Suppressions
+	if sf != nil {
+		sups = sf.suppressions
+	}
+	l := lint.New(rules, configs,
+		lint.Debug(c.DebugFlag),
+		lint.IgnoreCommentDisables(c.IgnoreCommentDisablesFlag),
+		lint.ReportUnusedDisables(c.ReportUnusedDisablesFlag),
+		lint.UseSuppressions(sups),
+		lint.RuleDocURLTemplate(c.RuleDocURLTemplate),
+	)
+	return &dirLinter{Linter: l, configs: configs, suppressions: sf}, nil
+}
+
+// printConfig prints the resolved configs that apply to the proto file
+// given with the print-config flag.
+func (c *cli) printConfig(rules lint.RuleRegistry, defaults, explicit lint.Configs) error {
+	if _, err := os.Stat(c.PrintConfigPath); err != nil {
 		return err
 	}
-	if _, err = w.Write(b); err != nil {
+	_, configs, err := c.discoverConfigs(rules, defaults, explicit, c.PrintConfigPath)
+	if err != nil {
 		return err
 	}
-
-	// Return error on lint failure which subsequently
-	// exits with a non-zero status code
-	if c.ExitStatusOnLintFailure && anyProblems(results) {
-		return ExitForLintFailure
+	// Configs match against the import path of a file.
+	name := c.PrintConfigPath
+	if names, err := protoparse.ResolveFilenames(c.ProtoImportPaths, name); err == nil {
+		name = names[0]
 	}
+	b, err := getOutputFormatFunc(c.FormatType)(configs.ForPath(name))
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(b)
+	return err
+}
 
-	return nil
+// findSourceFile returns the path of the proto file with the given import
+// name, or an empty string if it cannot be found in the import paths.
+func findSourceFile(importPaths []string, name string) string {
+	for _, dir := range importPaths {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
 }
 
 func anyProblems(results []lint.Response) bool {
@@ -34,6 +34,113 @@ func TestNewCli(t *testing.T) {
 				ProtoFiles:       []string{"a.proto", "b.proto"},
 			},
 		},
+		{
+			name: "PrintConfig",
+			inputArgs: []string{
+				"--print-config=a.proto",
+			},
+			wantCli: &cli{
+				PrintConfigPath:  "a.proto",
+				ProtoImportPaths: []string{"."},
+				ProtoFiles:       []string{},
+			},
+		},
+		{
+			name: "Plugins",
+			inputArgs: []string{
+				"--plugin=./acme-rules",
+				"--plugin=./other-rules",
+				"a.proto",
+			},
+			wantCli: &cli{
+				PluginPaths:      []string{"./acme-rules", "./other-rules"},
+				ProtoImportPaths: []string{"."},
+				ProtoFiles:       []string{"a.proto"},
+			},
+		},
+		{
+			name: "ListRulesFilters",
+			inputArgs: []string{
+				"--list-rules",
+				"--aep=131",
+				"--type=must",
+			},
+			wantCli: &cli{
+				ListRulesFlag:    true,
+				ListRulesAEP:     131,
+				ListRulesType:    "must",
+				ProtoImportPaths: []string{"."},
+				ProtoFiles:       []string{},
+			},
+		},
+		{
+			name: "Diff",
+			inputArgs: []string{
+				"--diff-base=origin/main",
+				"--diff-file=changes.diff",
+				"--diff-descriptors",
+				"a.proto",
+			},
+			wantCli: &cli{
+				DiffBase:            "origin/main",
+				DiffPath:            "changes.diff",
+				DiffDescriptorsFlag: true,
+				ProtoImportPaths:    []string{"."},
+				ProtoFiles:          []string{"a.proto"},
+			},
+		},
+		{
+			name: "DescriptorSetTargets",
+			inputArgs: []string{
+				"--descriptor-set-target=a.pb",
+				"--descriptor-set-target=b.binpb",
+			},
+			wantCli: &cli{
+				DescriptorSetTargets: []string{"a.pb", "b.binpb"},
+				ProtoImportPaths:     []string{"."},
+				ProtoFiles:           []string{},
+			},
+		},
+		{
+			name: "Exclude",
+			inputArgs: []string{
+				"--exclude=**/vendor/**",
+				"--exclude=apis/internal/**",
+				"./apis/...",
+			},
+			wantCli: &cli{
+				ExcludePatterns:  []string{"**/vendor/**", "apis/internal/**"},
+				ProtoImportPaths: []string{"."},
+				ProtoFiles:       []string{"./apis/..."},
+			},
+		},
+		{
+			name: "ExplainCommand",
+			inputArgs: []string{
+				"explain",
+				"--rule-doc-url-template=https://aep.dev/{aep}",
+				"core::0131::http-body",
+			},
+			wantCli: &cli{
+				Command:            "explain",
+				RuleDocURLTemplate: "https://aep.dev/{aep}",
+				ProtoImportPaths:   []string{"."},
+				ProtoFiles:         []string{"core::0131::http-body"},
+			},
+		},
+		{
+			name: "ExplainEnablementCommand",
+			inputArgs: []string{
+				"explain-enablement",
+				"core::0131::http-body",
+				"a.proto",
+			},
+			wantCli: &cli{
+				Command:          "explain-enablement",
+				ProtoImportPaths: []string{"."},
+				ProtoFiles:       []string{"core::0131::http-body", "a.proto"},
+			},
+		},
 		{
 			name: "ExitStatusOnLintFailure",
 			inputArgs: []string{
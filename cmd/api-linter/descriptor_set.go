@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// isDescriptorSetTarget returns whether a target is a compiled descriptor
+// set rather than a proto file.
+func isDescriptorSetTarget(target string) bool {
+	return strings.HasSuffix(target, ".pb") || strings.HasSuffix(target, ".binpb")
+}
+
+// splitDescriptorSetTargets moves the descriptor sets among the targets to
+// the descriptor set targets.
+func (c *cli) splitDescriptorSetTargets() {
+	var files []string
+	for _, target := range c.ProtoFiles {
+		if isDescriptorSetTarget(target) {
+			c.DescriptorSetTargets = append(c.DescriptorSetTargets, target)
+		} else {
+			files = append(files, target)
+		}
+	}
+	c.ProtoFiles = files
+}
+
+// loadDescriptorSetTargets returns the files to lint of the descriptor set
+// targets: every file of the sets, except the ones matching the exclude
+// patterns, the ones also given for imports, and the well-known and common
+// protos built into the linter, which sets written with the imports of
+// their files include.
+//
+// Imports are looked up in the sets themselves, then in the descriptor sets
+// given for imports, then among the well-known and common protos built
+// into the linter. The source code info of the files, if any, locates the
+// problems.
+func (c *cli) loadDescriptorSetTargets() ([]*desc.FileDescriptor, error) {
+	for _, pattern := range c.ExcludePatterns {
+		if !doublestar.ValidatePattern(pattern) {
+			return nil, fmt.Errorf("invalid exclude pattern %q", pattern)
+		}
+	}
+	protos := map[string]*dpb.FileDescriptorProto{}
+	var targets []string
+	for _, path := range c.DescriptorSetTargets {
+		fs, err := readFileDescriptorSet(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		for _, f := range fs.GetFile() {
+			if _, ok := protos[f.GetName()]; !ok {
+				protos[f.GetName()] = f
+			}
+			targets = append(targets, f.GetName())
+		}
+	}
+	imports := map[string]bool{}
+	for _, path := range c.ProtoDescPath {
+		fs, err := readFileDescriptorSet(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		for _, f := range fs.GetFile() {
+			imports[f.GetName()] = true
+			if _, ok := protos[f.GetName()]; !ok {
+				protos[f.GetName()] = f
+			}
+		}
+	}
+	skipped := func(name string) bool {
+		for _, pattern := range c.ExcludePatterns {
+			if matched, _ := doublestar.Match(pattern, name); matched {
+				return true
+			}
+		}
+		if imports[name] {
+			return true
+		}
+		_, err := protoregistry.GlobalFiles.FindFileByPath(name)
+		return err == nil
+	}
+
+	built := map[string]*desc.FileDescriptor{}
+	var build func(name string, importedBy []string) (*desc.FileDescriptor, error)
+	build = func(name string, importedBy []string) (*desc.FileDescriptor, error) {
+		if fd, ok := built[name]; ok {
+			return fd, nil
+		}
+		for _, n := range importedBy {
+			if n == name {
+				return nil, fmt.Errorf("import cycle: %s", strings.Join(append(importedBy, name), " -> "))
+			}
+		}
+		fdp, ok := protos[name]
+		if !ok {
+			fd, err := desc.LoadFileDescriptor(name)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not found", name)
+			}
+			built[name] = fd
+			return fd, nil
+		}
+		var deps []*desc.FileDescriptor
+		for _, dep := range fdp.GetDependency() {
+			fd, err := build(dep, append(importedBy, name))
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, fd)
+		}
+		fd, err := desc.CreateFileDescriptor(fdp, deps...)
+		if err != nil {
+			return nil, err
+		}
+		built[name] = fd
+		return fd, nil
+	}
+
+	var fds []*desc.FileDescriptor
+	seen := map[string]bool{}
+	for _, name := range targets {
+		if seen[name] || skipped(name) {
+			continue
+		}
+		seen[name] = true
+		fd, err := build(name, nil)
+		if err != nil {
+			return nil, err
+		}
+		fds = append(fds, fd)
+	}
+	return fds, nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestLintDescriptorSets(t *testing.T) {
+	t.Chdir(t.TempDir())
+	files := map[string]string{
+		"book.proto": `syntax = "proto3";
+package acme.library.v1;
+message Book {}
+`,
+		"library.proto": `syntax = "proto3";
+package acme.library.v1;
+import "book.proto";
+import "google/protobuf/empty.proto";
+service Library {
+  rpc GetBook(Book) returns (Book);
+  rpc Ping(google.protobuf.Empty) returns (google.protobuf.Empty);
+}
+`,
+	}
+	for path, content := range files {
+		if err := writeFile(path, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSet := func(path string, sourceInfo bool, names ...string) {
+		t.Helper()
+		p := protoparse.Parser{IncludeSourceCodeInfo: sourceInfo}
+		fds, err := p.ParseFiles(names...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fs := &dpb.FileDescriptorSet{}
+		for _, fd := range fds {
+			fs.File = append(fs.File, fd.AsFileDescriptorProto())
+		}
+		b, err := proto.Marshal(fs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, b, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A set with the imports of library.proto, as "protoc --include_imports"
+	// writes it, and sets of either file.
+	writeSet("all.binpb", true, "book.proto", "library.proto")
+	writeSet("library.pb", true, "library.proto")
+	writeSet("book.pb", false, "book.proto")
+	// Remove the sources, which must not be needed.
+	for path := range files {
+		if err := os.Remove(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "Positional",
+			args: []string{"all.binpb"},
+			want: []string{"file_path: library.proto", "line_number: 6", "file_path: book.proto"},
+		},
+		{
+			name:    "Exclude",
+			args:    []string{"all.binpb", "--exclude=book.proto"},
+			want:    []string{"file_path: library.proto"},
+			notWant: []string{"file_path: book.proto"},
+		},
+		{
+			name:    "Flag",
+			args:    []string{"--descriptor-set-target=library.pb", "--descriptor-set-in=book.pb"},
+			want:    []string{"file_path: library.proto", "line_number: 6"},
+			notWant: []string{"file_path: book.proto"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			args := append([]string{"-o=out.yaml", "--set-exit-status"}, test.args...)
+			if err := runCLI(args); !errors.Is(err, ExitForLintFailure) {
+				t.Fatalf("runCLI got error %v, but want %v", err, ExitForLintFailure)
+			}
+			out, err := os.ReadFile("out.yaml")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range test.want {
+				if !strings.Contains(string(out), want) {
+					t.Errorf("output %q does not contain %q", out, want)
+				}
+			}
+			for _, notWant := range test.notWant {
+				if strings.Contains(string(out), notWant) {
+					t.Errorf("output %q contains %q", out, notWant)
+				}
+			}
+		})
+	}
+
+	t.Run("WithoutSourceInfo", func(t *testing.T) {
+		if err := runCLI([]string{"-o=out.yaml", "book.pb"}); err != nil {
+			t.Fatal(err)
+		}
+		out, err := os.ReadFile("out.yaml")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Without source code info, the element and its source path locate
+		// the problem.
+		for _, want := range []string{"file_path: book.proto", "line_number: 0", "element: acme.library.v1.Book", "source_path: [4, 0]"} {
+			if !strings.Contains(string(out), want) {
+				t.Errorf("output %q does not contain %q", out, want)
+			}
+		}
+	})
+	t.Run("SuppressionsNotDiscovered", func(t *testing.T) {
+		// As configs, suppressions files are not discovered for the files
+		// of descriptor sets, which are not on disk.
+		if err := writeFile(".api-linter-suppressions.yaml", "- rule: core\n  element: acme.**\n"); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(".api-linter-suppressions.yaml")
+		if err := runCLI([]string{"-o=out.yaml", "--set-exit-status", "all.binpb"}); !errors.Is(err, ExitForLintFailure) {
+			t.Errorf("runCLI got error %v, but want %v", err, ExitForLintFailure)
+		}
+	})
+	t.Run("Diff", func(t *testing.T) {
+		// The files of descriptor sets are not on disk, so the diff cannot
+		// filter their problems.
+		if err := writeFile("empty.diff", ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := runCLI([]string{"-o=out.yaml", "--set-exit-status", "--diff-file=empty.diff", "library.pb", "--descriptor-set-in=book.pb"}); !errors.Is(err, ExitForLintFailure) {
+			t.Errorf("runCLI got error %v, but want %v", err, ExitForLintFailure)
+		}
+	})
+	t.Run("MissingImport", func(t *testing.T) {
+		err := runCLI([]string{"-o=out.yaml", "library.pb"})
+		if err == nil || !strings.Contains(err.Error(), "book.proto") {
+			t.Errorf("runCLI got error %v, but want one for the missing import", err)
+		}
+	})
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aep-dev/api-linter/lint"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// changedLines holds the one-based numbers of the added or modified lines
+// of each file, by absolute path.
+type changedLines map[string]map[int]bool
+
+// readDiff returns the lines changed by the diff given with the diff-base
+// or diff-file flag, or nil if neither is given.
+func (c *cli) readDiff() (changedLines, error) {
+	switch {
+	case c.DiffBase != "" && c.DiffPath != "":
+		return nil, fmt.Errorf("--diff-base and --diff-file cannot be used together")
+	case c.DiffBase != "":
+		return gitDiff(c.DiffBase)
+	case c.DiffPath == "-":
+		return parseDiff(os.Stdin)
+	case c.DiffPath != "":
+		f, err := os.Open(c.DiffPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return parseDiff(f)
+	}
+	return nil, nil
+}
+
+// gitDiff returns the lines of the working tree changed since the git
+// revision, running git in the current directory. Every line of untracked
+// files is changed.
+func gitDiff(base string) (changedLines, error) {
+	out, err := runGit("diff", "--no-color", "--no-ext-diff", "--relative", "--unified=0",
+		"--src-prefix=a/", "--dst-prefix=b/", base, "--")
+	if err != nil {
+		return nil, err
+	}
+	changed, err := parseDiff(bytes.NewReader(out))
+	if err != nil {
+		return nil, err
+	}
+	untracked, err := runGit("ls-files", "-z", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range strings.Split(string(untracked), "\x00") {
+		if name == "" {
+			continue
+		}
+		if err := changed.addFile(name); err != nil {
+			return nil, err
+		}
+	}
+	return changed, nil
+}
+
+// runGit runs git in the current directory, returning its stdout.
+func runGit(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// addFile marks every line of the file at the given path as changed.
+func (c changedLines) addFile(name string) error {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	path, err := filepath.Abs(name)
+	if err != nil {
+		return err
+	}
+	lines := map[int]bool{}
+	for l := 1; l <= bytes.Count(b, []byte("\n"))+1; l++ {
+		lines[l] = true
+	}
+	c[path] = lines
+	return nil
+}
+
+var hunkHeader = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// parseDiff returns the lines added or modified by a unified diff, whose
+// paths are relative to the current directory.
+func parseDiff(r io.Reader) (changedLines, error) {
+	changed := changedLines{}
+	var lines map[int]bool
+	// The next line of the new file, and the lines left in the hunk.
+	line, oldLeft, newLeft := 0, 0, 0
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1024*1024)
+	for s.Scan() {
+		text := s.Text()
+		if oldLeft > 0 || newLeft > 0 {
+			switch {
+			case strings.HasPrefix(text, "+"):
+				if lines != nil {
+					lines[line] = true
+				}
+				line++
+				newLeft--
+			case strings.HasPrefix(text, "-"):
+				oldLeft--
+			case strings.HasPrefix(text, " "), text == "":
+				line++
+				oldLeft--
+				newLeft--
+			}
+			continue
+		}
+		switch {
+		case strings.HasPrefix(text, "+++ "):
+			lines = nil
+			name, _, _ := strings.Cut(strings.TrimPrefix(text, "+++ "), "\t")
+			if unquoted, err := strconv.Unquote(name); err == nil {
+				name = unquoted
+			}
+			if name == "/dev/null" {
+				continue
+			}
+			path, err := filepath.Abs(strings.TrimPrefix(name, "b/"))
+			if err != nil {
+				return nil, err
+			}
+			lines = map[int]bool{}
+			changed[path] = lines
+		case strings.HasPrefix(text, "@@"):
+			m := hunkHeader.FindStringSubmatch(text)
+			if m == nil {
+				return nil, fmt.Errorf("invalid hunk header %q", text)
+			}
+			line, _ = strconv.Atoi(m[2])
+			oldLeft, newLeft = hunkLength(m[1]), hunkLength(m[3])
+		}
+	}
+	return changed, s.Err()
+}
+
+// hunkLength returns the number of lines of a hunk range, which is 1 if
+// it is left out.
+func hunkLength(s string) int {
+	if s == "" {
+		return 1
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// filter keeps the problems of a response whose location overlaps a
+// changed line, or, if withDescriptors is set, whose descriptor's
+// definition does. The path is the path of the linted file, or empty if it
+// is not on disk, such as the files of a descriptor set; since they cannot
+// be in the diff, all of their problems are kept.
+func (c changedLines) filter(resp lint.Response, path string, withDescriptors bool) lint.Response {
+	if path == "" {
+		return resp
+	}
+	lines := map[int]bool{}
+	if abs, err := filepath.Abs(path); err == nil {
+		lines = c[abs]
+	}
+	problems := []lint.Problem{}
+	for _, p := range resp.Problems {
+		loc := p.Location
+		if loc == nil {
+			loc = p.Descriptor.GetSourceInfo()
+		}
+		if overlaps(lines, loc) || withDescriptors && overlaps(lines, p.Descriptor.GetSourceInfo()) {
+			problems = append(problems, p)
+		}
+	}
+	resp.Problems = problems
+	return resp
+}
+
+// overlaps returns whether a location spans any of the lines.
+func overlaps(lines map[int]bool, loc *dpb.SourceCodeInfo_Location) bool {
+	span := loc.GetSpan()
+	if len(span) < 3 {
+		return false
+	}
+	first, last := int(span[0])+1, int(span[0])+1
+	if len(span) == 4 {
+		last = int(span[2]) + 1
+	}
+	for l := first; l <= last; l++ {
+		if lines[l] {
+			return true
+		}
+	}
+	return false
+}
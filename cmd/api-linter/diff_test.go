@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseDiff(t *testing.T) {
+	diff := `diff --git a/a.proto b/a.proto
+index 1111111..2222222 100644
+--- a/a.proto
++++ b/a.proto
+@@ -2,3 +2,4 @@ syntax = "proto3";
+ package a;
+--- removed comment
++++ added comment
++message A {}
+ message B {}
+@@ -10 +11,0 @@ message C {}
+-message D {}
+@@ -20,0 +21 @@ message E {}
++message F {}
+diff --git a/old.proto b/old.proto
+deleted file mode 100644
+--- a/old.proto
++++ /dev/null
+@@ -1 +0,0 @@
+-syntax = "proto3";
+--- b.proto	2024-01-01 00:00:00
++++ b.proto	2024-01-02 00:00:00
+@@ -1 +1 @@
+-syntax = "proto2";
++syntax = "proto3";
+`
+	got, err := parseDiff(strings.NewReader(diff))
+	if err != nil {
+		t.Fatal(err)
+	}
+	abs := func(name string) string {
+		path, err := filepath.Abs(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	want := changedLines{
+		abs("a.proto"): {3: true, 4: true, 21: true},
+		abs("b.proto"): {1: true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseDiff() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiffBase(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+	t.Chdir(t.TempDir())
+	git := func(args ...string) {
+		t.Helper()
+		args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	proto := `syntax = "proto3";
+
+service Library {
+  rpc GetBook(Book)
+    returns (Book);
+}
+
+message Book {}
+message Shelf {}
+`
+	if err := writeFile("test.proto", proto); err != nil {
+		t.Fatal(err)
+	}
+	git("init", "-q")
+	git("add", "test.proto")
+	git("commit", "-q", "-m", "init")
+
+	proto = strings.Replace(proto, "returns (Book);", "returns (Book); // Changed.", 1)
+	proto = strings.Replace(proto, "}\n\nmessage", "  rpc GetShelf(Shelf) returns (Shelf);\n}\n\nmessage", 1)
+	if err := writeFile("test.proto", proto); err != nil {
+		t.Fatal(err)
+	}
+	// Untracked files are changed entirely.
+	untracked := `syntax = "proto3";
+
+service Archive {
+  rpc GetRecord(Record) returns (Record);
+}
+
+message Record {}
+`
+	if err := writeFile("untracked.proto", untracked); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "ChangedLines",
+			args:    []string{"--diff-base=HEAD"},
+			want:    []string{"GetShelfRequest"},
+			notWant: []string{"GetBookRequest"},
+		},
+		{
+			name: "ChangedDescriptors",
+			args: []string{"--diff-base=HEAD", "--diff-descriptors"},
+			want: []string{"GetShelfRequest", "GetBookRequest"},
+		},
+		{
+			name: "UntrackedFile",
+			args: []string{"--diff-base=HEAD", "untracked.proto"},
+			want: []string{"GetShelfRequest", "GetRecordRequest"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			args := append([]string{"-o=out.yaml", "--set-exit-status", "test.proto"}, test.args...)
+			if err := runCLI(args); !errors.Is(err, ExitForLintFailure) {
+				t.Fatalf("runCLI got error %v, but want %v", err, ExitForLintFailure)
+			}
+			out, err := os.ReadFile("out.yaml")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range test.want {
+				if !strings.Contains(string(out), want) {
+					t.Errorf("output %q does not contain %q", out, want)
+				}
+			}
+			for _, notWant := range test.notWant {
+				if strings.Contains(string(out), notWant) {
+					t.Errorf("output %q contains %q", out, notWant)
+				}
+			}
+		})
+	}
+
+	if err := runCLI([]string{"--diff-base=HEAD", "--diff-file=-", "test.proto"}); err == nil || errors.Is(err, ExitForLintFailure) {
+		t.Errorf("runCLI got error %v, but want an error for conflicting flags", err)
+	}
+
+	// Without problems on the changed lines, the exit status is zero.
+	if err := writeFile("empty.diff", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := runCLI([]string{"-o=out.yaml", "--set-exit-status", "--diff-file=empty.diff", "test.proto"}); err != nil {
+		t.Errorf("runCLI got error %v for a diff without changes, but want none", err)
+	}
+}
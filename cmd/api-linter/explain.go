@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aep-dev/api-linter/docs"
+	"github.com/aep-dev/api-linter/lint"
+)
+
+// explainedRule is a rule along with its documentation.
+type explainedRule struct {
+	listedRule    `yaml:",inline"`
+	Documentation string `json:"documentation,omitempty" yaml:"documentation,omitempty"`
+}
+
+// explain prints the documentation of a rule, embedded in the binary.
+//
+// Usage: api-linter explain <rule>
+func (c *cli) explain(rules lint.RuleRegistry) error {
+	if len(c.ProtoFiles) != 1 {
+		return fmt.Errorf("usage: api-linter %s <rule>", explainCommand)
+	}
+	name := lint.RuleName(c.ProtoFiles[0])
+
+	rules, _, err := c.explicitConfigs(rules)
+	if err != nil {
+		return err
+	}
+	rule, ok := rules[name]
+	if !ok {
+		return fmt.Errorf("unknown rule %q", name)
+	}
+	e := explainedRule{
+		listedRule:    describeRule(rule, c.RuleDocURLTemplate),
+		Documentation: docs.RuleDoc(string(name)),
+	}
+
+	// Print a human-readable explanation, unless a format is requested.
+	b := []byte(e.String())
+	if c.FormatType != "" {
+		if b, err = getOutputFormatFunc(c.FormatType)(e); err != nil {
+			return err
+		}
+	}
+	_, err = os.Stdout.Write(b)
+	return err
+}
+
+// String renders the rule and its documentation for a terminal.
+func (e explainedRule) String() string {
+	var b strings.Builder
+	fmt.Fprintln(&b, e.Name)
+	var facts []string
+	if e.AEP != 0 {
+		aep := fmt.Sprintf("AEP-%d", e.AEP)
+		if e.AEPTitle != "" {
+			aep += fmt.Sprintf(" (%s)", e.AEPTitle)
+		}
+		facts = append(facts, aep)
+	}
+	if e.Type != "" {
+		facts = append(facts, e.Type)
+	}
+	if e.DefaultEnabled {
+		facts = append(facts, "enabled by default")
+	} else {
+		facts = append(facts, "disabled by default")
+	}
+	if e.Fixable {
+		facts = append(facts, "fixable")
+	}
+	fmt.Fprintln(&b, strings.Join(facts, ", "))
+	if e.DocURL != "" {
+		fmt.Fprintln(&b, e.DocURL)
+	}
+	if e.Documentation == "" {
+		if e.Description != "" {
+			fmt.Fprintf(&b, "\n%s\n", e.Description)
+		}
+		return b.String()
+	}
+	fmt.Fprintf(&b, "\n%s", renderMarkdown(e.Documentation))
+	return b.String()
+}
+
+// renderMarkdown renders the Markdown of the rule documentation as plain
+// text: headings are underlined, code blocks are indented, and the Jekyll
+// tags of the documentation site are dropped.
+func renderMarkdown(md string) string {
+	var b strings.Builder
+	inCode := false
+	for _, line := range strings.Split(md, "\n") {
+		switch {
+		case strings.HasPrefix(line, "```"):
+			inCode = !inCode
+			continue
+		case inCode:
+			fmt.Fprintf(&b, "    %s\n", line)
+			continue
+		case strings.HasPrefix(strings.TrimSpace(line), "{%"):
+			continue
+		}
+		if heading, ok := strings.CutPrefix(line, "# "); ok {
+			fmt.Fprintf(&b, "%s\n%s\n", heading, strings.Repeat("=", len(heading)))
+		} else if heading, ok := strings.CutPrefix(line, "## "); ok {
+			fmt.Fprintf(&b, "%s\n%s\n", heading, strings.Repeat("-", len(heading)))
+		} else {
+			fmt.Fprintln(&b, strings.TrimLeft(line, "# "))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aep-dev/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// explainEnablement prints why a rule is enabled or disabled for a proto
+// file, or for an element within it.
+//
+// Usage: api-linter explain-enablement <rule> <file.proto> [element]
+func (c *cli) explainEnablement(rules lint.RuleRegistry, configs lint.Configs) error {
+	if len(c.ProtoFiles) < 2 || len(c.ProtoFiles) > 3 {
+		return fmt.Errorf("usage: api-linter %s <rule> <file.proto> [fully.qualified.Element]", explainEnablementCommand)
+	}
+	ruleName, file := lint.RuleName(c.ProtoFiles[0]), c.ProtoFiles[1]
+
+	rules, explicitConfigs, err := c.explicitConfigs(rules)
+	if err != nil {
+		return err
+	}
+	fds, err := c.parseFiles(file)
+	if err != nil {
+		return err
+	}
+	fd := fds[0]
+	l, err := c.newLinter(rules, configs, explicitConfigs, suppressionsFiles{}, findSourceFile(c.ProtoImportPaths, fd.GetName()))
+	if err != nil {
+		return err
+	}
+
+	var d desc.Descriptor = fd
+	if len(c.ProtoFiles) == 3 {
+		if d = fd.FindSymbol(c.ProtoFiles[2]); d == nil {
+			return fmt.Errorf("element %q is not defined in %q", c.ProtoFiles[2], fd.GetName())
+		}
+	}
+	e, err := l.ExplainRuleEnablement(ruleName, d)
+	if err != nil {
+		return err
+	}
+
+	// Print a human-readable explanation, unless a format is requested.
+	b := []byte(e.String())
+	if c.FormatType != "" {
+		if b, err = getOutputFormatFunc(c.FormatType)(e); err != nil {
+			return err
+		}
+	}
+	_, err = os.Stdout.Write(b)
+	return err
+}
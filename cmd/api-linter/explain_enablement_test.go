@@ -0,0 +1,73 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExplainEnablement(t *testing.T) {
+	tempDir := t.TempDir()
+	proto := `
+	syntax = "proto3";
+
+	service Library {
+		// (-- api-linter: core::0131::request-message-name=disabled --)
+		rpc GetBook(Book) returns (Book);
+	}
+
+	message Book {}
+	`
+	if err := writeFile(filepath.Join(tempDir, "test.proto"), proto); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			"File",
+			[]string{"core::0131::request-message-name", "test.proto"},
+			"core::0131::request-message-name is enabled for test.proto",
+		},
+		{
+			"Element",
+			[]string{"core::0131::request-message-name", "test.proto", "Library.GetBook"},
+			`Library.GetBook: disabled by the comment "(-- api-linter: core::0131::request-message-name=disabled --)"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			args := append([]string{"explain-enablement", "-I=" + tempDir}, test.args...)
+			out := captureStdout(t, func() error { return runCLI(args) })
+			if !strings.Contains(out, test.want) {
+				t.Errorf("explain-enablement got %q, but want it to contain %q", out, test.want)
+			}
+		})
+	}
+}
+
+// captureStdout returns what the given function writes to stdout.
+func captureStdout(t *testing.T, f func() error) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	ferr := f()
+	os.Stdout = stdout
+	w.Close()
+	if ferr != nil {
+		t.Fatal(ferr)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			"Text",
+			[]string{"core::0131::http-body"},
+			[]string{
+				"AEP-131 (Standard methods: Get), must, enabled by default",
+				"https://linter.aep.dev/131/http-body",
+				"Get methods: No HTTP body\n=========================",
+				"Examples\n--------",
+				"    rpc GetBook(GetBookRequest) returns (Book) {",
+				"Disabling\n---------",
+			},
+		},
+		{
+			"Template",
+			[]string{"--rule-doc-url-template=https://aep.dev/{aep}", "core::0131::http-body"},
+			[]string{"\nhttps://aep.dev/131\n"},
+		},
+		{
+			"JSON",
+			[]string{"--output-format=json", "core::0131::http-body"},
+			[]string{`"name":"core::0131::http-body"`, `"documentation":"# Get methods: No HTTP body`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out := captureStdout(t, func() error { return runCLI(append([]string{"explain"}, test.args...)) })
+			for _, want := range test.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("explain got %q, but want it to contain %q", out, want)
+				}
+			}
+			if strings.Contains(out, "{%") {
+				t.Errorf("explain got %q, but want it without Jekyll tags", out)
+			}
+		})
+	}
+}
+
+func TestExplain_UnknownRule(t *testing.T) {
+	if err := runCLI([]string{"explain", "core::0131::unknown"}); err == nil || !strings.Contains(err.Error(), "unknown rule") {
+		t.Errorf("explain got error %v, but want an unknown rule error", err)
+	}
+}
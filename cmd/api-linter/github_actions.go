@@ -57,6 +57,10 @@ func formatGitHubActionOutput(responses []lint.Response) []byte {
 			runeThatLooksLikeTwoColonsButIsActuallyTwoArmenianFullStops := "։։"
 			title := strings.ReplaceAll(string(problem.RuleID), "::", runeThatLooksLikeTwoColonsButIsActuallyTwoArmenianFullStops)
 			message := strings.ReplaceAll(problem.Message, "\n", "\\n")
+			// Without a position in the file, name the element instead.
+			if element := problem.Element(); !problem.HasSpan() && element != "" {
+				message = element + ": " + message
+			}
 			uri := problem.GetRuleURI()
 			if uri != "" {
 				message += "\\n\\n" + uri
@@ -19,6 +19,7 @@ import (
 
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc/protoparse"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -98,8 +99,8 @@ func TestFormatGitHubActionOutput(t *testing.T) {
 					},
 				},
 			},
-			want: `::error file=example.proto,endColumn=4,endLine=3,col=2,line=1,title=core։։naming_formats։։field_names::\n\nhttps://linter.aip.dev/naming_formats/field_names
-::error file=example.proto,endColumn=8,endLine=7,col=6,line=5,title=core։։naming_formats։։field_names::multi\nline\ncomment\n\nhttps://linter.aip.dev/naming_formats/field_names
+			want: `::error file=example.proto,endColumn=4,endLine=3,col=2,line=1,title=core։։naming_formats։։field_names::\n\nhttps://linter.aep.dev/naming_formats/field_names
+::error file=example.proto,endColumn=8,endLine=7,col=6,line=5,title=core։։naming_formats։։field_names::multi\nline\ncomment\n\nhttps://linter.aep.dev/naming_formats/field_names
 `,
 		},
 		{
@@ -133,13 +134,13 @@ func TestFormatGitHubActionOutput(t *testing.T) {
 					},
 				},
 			},
-			want: `::error file=example.proto,title=core։։naming_formats։։field_names::\n\nhttps://linter.aip.dev/naming_formats/field_names
-::error file=example.proto,title=core։։naming_formats։։field_names::\n\nhttps://linter.aip.dev/naming_formats/field_names
-::error file=example2.proto,title=core։։0131։։request_message։։name::\n\nhttps://linter.aip.dev/131/request_message/name
-::error file=example2.proto,title=core։։0132։։response_message։։name::\n\nhttps://linter.aip.dev/132/response_message/name
-::error file=example3.proto,title=core։։naming_formats։։field_names::\n\nhttps://linter.aip.dev/naming_formats/field_names
-::error file=example4.proto,title=core։։naming_formats։։field_names::\n\nhttps://linter.aip.dev/naming_formats/field_names
-::error file=example4.proto,title=core։։0132։։response_message։։name::\n\nhttps://linter.aip.dev/132/response_message/name
+			want: `::error file=example.proto,title=core։։naming_formats։։field_names::\n\nhttps://linter.aep.dev/naming_formats/field_names
+::error file=example.proto,title=core։։naming_formats։։field_names::\n\nhttps://linter.aep.dev/naming_formats/field_names
+::error file=example2.proto,title=core։։0131։։request_message։։name::\n\nhttps://linter.aep.dev/131/request_message/name
+::error file=example2.proto,title=core։։0132։։response_message։։name::\n\nhttps://linter.aep.dev/132/response_message/name
+::error file=example3.proto,title=core։։naming_formats։։field_names::\n\nhttps://linter.aep.dev/naming_formats/field_names
+::error file=example4.proto,title=core։։naming_formats։։field_names::\n\nhttps://linter.aep.dev/naming_formats/field_names
+::error file=example4.proto,title=core։։0132։։response_message։։name::\n\nhttps://linter.aep.dev/132/response_message/name
 `,
 		},
 	}
@@ -152,3 +153,27 @@ func TestFormatGitHubActionOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatGitHubActionOutput_WithoutSourceInfo(t *testing.T) {
+	p := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"example.proto": "syntax = \"proto3\";\npackage acme.v1;\nmessage Book {}\n",
+		}),
+	}
+	fds, err := p.ParseFiles("example.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []lint.Response{{
+		FilePath: "example.proto",
+		Problems: []lint.Problem{{
+			RuleID:     "core::naming_formats::field_names",
+			Message:    "Bad name.",
+			Descriptor: fds[0].GetMessageTypes()[0],
+		}},
+	}}
+	want := "::error file=example.proto,title=core։։naming_formats։։field_names::acme.v1.Book: Bad name.\\n\\nhttps://linter.aep.dev/naming_formats/field_names\n"
+	if diff := cmp.Diff(want, string(formatGitHubActionOutput(data))); diff != "" {
+		t.Errorf("formatGitHubActionOutput() mismatch (-want +got):\n%s", diff)
+	}
+}
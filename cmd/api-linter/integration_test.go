@@ -15,6 +15,7 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -111,6 +112,202 @@ func TestRules_DisabledByInlineComments(t *testing.T) {
 	}
 }
 
+func TestReportUnusedDisables(t *testing.T) {
+	for _, test := range testCases {
+		t.Run(test.testName, func(t *testing.T) {
+			// The rule is disabled on the file, and again (needlessly) inline.
+			disableInFile := fmt.Sprintf("// (-- api-linter: %s=disabled --)", test.rule)
+			disableInline := fmt.Sprintf("(-- api-linter: %s=disabled --)", test.rule)
+			proto := disableInFile + "\n" + strings.Replace(test.proto, "disable-me-here", disableInline, -1)
+			_, result := runLinterWithFailureStatus(t, proto, "", []string{"--report-unused-disables"})
+			if got := strings.Count(result, "api-linter::unused-disable"); got != 1 {
+				t.Errorf("got %d unused disable problems, want 1:\n%s", got, result)
+			}
+		})
+	}
+}
+
+func TestRules_DisabledBySuppressions(t *testing.T) {
+	tempDir := t.TempDir()
+	suppressionsPath := filepath.Join(tempDir, "suppressions.yaml")
+	suppressions := `
+- rule: core::0131::request-message-name
+  element: Library.GetBook
+  reason: Generated code.
+- rule: core::0131::request-message-name
+  element: Library.GetShelf
+  reason: No longer exists.
+`
+	if err := writeFile(suppressionsPath, suppressions); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range testCases {
+		t.Run(test.testName, func(t *testing.T) {
+			_, result := runLinterWithFailureStatus(t, test.proto, "", []string{
+				"--suppressions=" + suppressionsPath,
+				"--report-stale-suppressions",
+			})
+			if strings.Contains(result, "rule_id: "+test.rule) {
+				t.Errorf("rule %q should be suppressed by the suppressions file", test.rule)
+			}
+			if got := strings.Count(result, "api-linter::stale-suppression"); got != 1 {
+				t.Errorf("got %d stale suppression problems, want 1:\n%s", got, result)
+			}
+		})
+	}
+}
+
+func TestSuppressions_Invalid(t *testing.T) {
+	tempDir := t.TempDir()
+	suppressionsPath := filepath.Join(tempDir, "suppressions.yaml")
+	if err := writeFile(suppressionsPath, "- rule: core::0131::request-mesage-name\n  element: Library.GetBook\n"); err != nil {
+		t.Fatal(err)
+	}
+	protoPath := filepath.Join(tempDir, "test.proto")
+	if err := writeFile(protoPath, testCases[0].proto); err != nil {
+		t.Fatal(err)
+	}
+	err := runCLI([]string{
+		"-I=" + tempDir,
+		"-o=" + filepath.Join(tempDir, "test.out"),
+		"--descriptor-set-in=internal/testdata/dummy.protoset",
+		"--suppressions=" + suppressionsPath,
+		"test.proto",
+	})
+	if err == nil || !strings.Contains(err.Error(), `did you mean "core::0131::request-message-name"?`) {
+		t.Errorf("runCLI got error %v, but want one for the unknown rule", err)
+	}
+}
+
+func TestStaleSuppressions_DisabledByConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	suppressionsPath := filepath.Join(tempDir, "suppressions.yaml")
+	if err := writeFile(suppressionsPath, "- rule: core::0131::request-message-name\n  element: Library.GetShelf\n"); err != nil {
+		t.Fatal(err)
+	}
+	config := `[{"disabled_rules": ["api-linter::stale-suppression"]}]`
+	_, result := runLinterWithFailureStatus(t, testCases[0].proto, config, []string{
+		"--suppressions=" + suppressionsPath,
+		"--report-stale-suppressions",
+	})
+	if strings.Contains(result, "api-linter::stale-suppression") {
+		t.Errorf("stale suppressions should be disabled by the config:\n%s", result)
+	}
+}
+
+func TestDiscoveredConfig_IncludedPaths(t *testing.T) {
+	// The paths of a discovered config match the names of the proto files,
+	// relative to their import path, not to the directory of the config.
+	tests := []struct {
+		name         string
+		includedPath string
+		wantDisabled bool
+	}{
+		{"ImportRelative", "acme/v1/*.proto", true},
+		{"ConfigRelative", "v1/*.proto", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if err := os.Mkdir(filepath.Join(tempDir, ".git"), os.ModePerm); err != nil {
+				t.Fatal(err)
+			}
+			rule := testCases[0].rule
+			config := fmt.Sprintf("- included_paths: [%q]\n  disabled_rules: [%q]\n", test.includedPath, rule)
+			if err := writeFile(filepath.Join(tempDir, "apis", "acme", ".api-linter.yaml"), config); err != nil {
+				t.Fatal(err)
+			}
+			if err := writeFile(filepath.Join(tempDir, "apis", "acme", "v1", "test.proto"), testCases[0].proto); err != nil {
+				t.Fatal(err)
+			}
+			outPath := filepath.Join(tempDir, "test.out")
+			args := []string{
+				fmt.Sprintf("-o=%s", outPath),
+				fmt.Sprintf("-I=%s", filepath.Join(tempDir, "apis")),
+				"--descriptor-set-in=internal/testdata/dummy.protoset",
+				"acme/v1/test.proto",
+			}
+			if err := runCLI(args); err != nil {
+				t.Fatal(err)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if disabled := !strings.Contains(string(out), rule); disabled != test.wantDisabled {
+				t.Errorf("rule %q disabled is %v with included path %q, but want %v", rule, disabled, test.includedPath, test.wantDisabled)
+			}
+		})
+	}
+}
+
+func TestRules_DisabledByDiscoveredSuppressions(t *testing.T) {
+	for _, test := range testCases {
+		t.Run(test.testName, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if err := os.Mkdir(filepath.Join(tempDir, ".git"), os.ModePerm); err != nil {
+				t.Fatal(err)
+			}
+			suppressions := fmt.Sprintf(`
+- rule: %q
+  element: Library.GetBook
+- rule: %q
+  element: Library.GetShelf
+`, test.rule, test.rule)
+			if err := writeFile(filepath.Join(tempDir, "apis", ".api-linter-suppressions.yaml"), suppressions); err != nil {
+				t.Fatal(err)
+			}
+			if err := writeFile(filepath.Join(tempDir, "apis", "test.proto"), test.proto); err != nil {
+				t.Fatal(err)
+			}
+			outPath := filepath.Join(tempDir, "test.out")
+			args := []string{
+				fmt.Sprintf("-o=%s", outPath),
+				fmt.Sprintf("-I=%s", tempDir),
+				"--descriptor-set-in=internal/testdata/dummy.protoset",
+				"--report-stale-suppressions",
+				"--output-format=json",
+				"apis/test.proto",
+			}
+			if err := runCLI(args); err != nil && !errors.Is(err, ExitForLintFailure) {
+				t.Fatal(err)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var results []struct {
+				FilePath string `json:"file_path"`
+				Problems []struct {
+					RuleID   string `json:"rule_id"`
+					Location struct {
+						StartPosition struct {
+							LineNumber int `json:"line_number"`
+						} `json:"start_position"`
+					} `json:"location"`
+				} `json:"problems"`
+			}
+			if err := json.Unmarshal(out, &results); err != nil {
+				t.Fatal(err)
+			}
+			var stale []int
+			for _, r := range results {
+				for _, p := range r.Problems {
+					if p.RuleID == test.rule {
+						t.Errorf("rule %q should be suppressed by the discovered suppressions file", test.rule)
+					}
+					if p.RuleID == "api-linter::stale-suppression" {
+						stale = append(stale, p.Location.StartPosition.LineNumber)
+					}
+				}
+			}
+			if len(stale) != 1 || stale[0] != 4 {
+				t.Errorf("got stale suppression problems on lines %v, want [4]:\n%s", stale, out)
+			}
+		})
+	}
+}
+
 func TestRules_DisabledByConfig(t *testing.T) {
 	config := `
 	[
@@ -131,25 +328,164 @@ func TestRules_DisabledByConfig(t *testing.T) {
 	}
 }
 
+func TestRules_DisabledByDiscoveredConfig(t *testing.T) {
+	for _, test := range testCases {
+		t.Run(test.testName, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if err := os.Mkdir(filepath.Join(tempDir, ".git"), os.ModePerm); err != nil {
+				t.Fatal(err)
+			}
+			config := fmt.Sprintf("- disabled_rules: [%q]\n", test.rule)
+			if err := writeFile(filepath.Join(tempDir, "apis", ".api-linter.yaml"), config); err != nil {
+				t.Fatal(err)
+			}
+			if err := writeFile(filepath.Join(tempDir, "apis", "test.proto"), test.proto); err != nil {
+				t.Fatal(err)
+			}
+			outPath := filepath.Join(tempDir, "test.out")
+			args := []string{
+				fmt.Sprintf("-o=%s", outPath),
+				fmt.Sprintf("-I=%s", tempDir),
+				"--descriptor-set-in=internal/testdata/dummy.protoset",
+				"apis/test.proto",
+			}
+			if err := runCLI(args); err != nil {
+				t.Fatal(err)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(string(out), test.rule) {
+				t.Errorf("rule %q should be disabled by the discovered config", test.rule)
+			}
+		})
+	}
+}
+
+func TestCustomRules(t *testing.T) {
+	config := `
+	[
+		{
+			"custom_rules": [
+				{
+					"name": "acme::0001::no-data-fields",
+					"target": "field",
+					"report_if": "name == 'data'",
+					"message": "Field {{.full_name}} is too vague."
+				}
+			]
+		},
+		{
+			"included_paths": ["other.proto"],
+			"disabled_rules": ["acme::0001"]
+		}
+	]
+	`
+	proto := `
+	syntax = "proto3";
+	package acme.library.v1;
+	message Book {
+		bytes data = 1;
+	}
+	`
+	result := runLinter(t, proto, config)
+	if want := "Field acme.library.v1.Book.data is too vague."; !strings.Contains(result, want) {
+		t.Errorf("got %q, but want it to contain %q", result, want)
+	}
+	if want := "rule_id: acme::0001::no-data-fields"; !strings.Contains(result, want) {
+		t.Errorf("got %q, but want it to contain %q", result, want)
+	}
+
+	disabled := strings.Replace(config, "other.proto", "test.proto", 1)
+	if result := runLinter(t, proto, disabled); strings.Contains(result, "acme::0001::no-data-fields") {
+		t.Errorf("custom rule should be disabled by the user config: %q", result)
+	}
+}
+
+func TestInvalidConfigs(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.yaml")
+	if err := writeFile(configPath, "- disabled_rules: ['core::0131::request-mesage-name']\n"); err != nil {
+		t.Fatal(err)
+	}
+	customRulePath := filepath.Join(tempDir, "custom.yaml")
+	if err := writeFile(customRulePath, "- custom_rules: [{name: 'acme::a', target: field, report_if: 'name ==', message: 'Bad.'}]\n"); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"ConfigFile", []string{"--config=" + configPath}, `did you mean "core::0131::request-message-name"?`},
+		{"DisableRuleFlag", []string{"--disable-rule=core::0131::no-such-rule"}, `"core::0131::no-such-rule" does not match any rule`},
+		{"CustomRule", []string{"--config=" + customRulePath}, `custom rule "acme::a": report_if`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := runCLI(append(test.args, "internal/testdata/dummy.proto"))
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("runCLI() got error %v, but want it to contain %q", err, test.want)
+			}
+		})
+	}
+}
+
 func TestBuildErrors(t *testing.T) {
-	expected := []string{
-		"internal/testdata/build_errors.proto:8:1:",
-		"internal/testdata/build_errors.proto:13:1:",
-	}
-	err := runCLI([]string{"internal/testdata/build_errors.proto"})
-	if err == nil {
-		t.Fatal("expected build error for build_errors.proto")
-	}
-	actual := err.Error()
-	actualLines := strings.Split(strings.TrimSpace(actual), "\n")
-	for idx, line := range actualLines {
-		if idx := strings.IndexByte(line, ' '); idx > -1 {
-			line = line[:idx]
+	outPath := filepath.Join(t.TempDir(), "out.json")
+	err := runCLI([]string{
+		"--output-format=json",
+		"-o=" + outPath,
+		"internal/testdata/build_errors.proto",
+		"internal/testdata/dummy.proto",
+	})
+	if !errors.Is(err, ErrParseFailure) {
+		t.Fatalf("runCLI got error %v, but want %v", err, ErrParseFailure)
+	}
+	out, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var responses []struct {
+		FilePath string `json:"file_path"`
+		Problems []struct {
+			RuleID   string `json:"rule_id"`
+			Category string `json:"category"`
+			Location struct {
+				Start struct {
+					Line   int `json:"line_number"`
+					Column int `json:"column_number"`
+				} `json:"start_position"`
+			} `json:"location"`
+		} `json:"problems"`
+	}
+	if err := json.Unmarshal(out, &responses); err != nil {
+		t.Fatal(err)
+	}
+
+	// The parse errors are reported with their positions, and the file that
+	// parses is linted nonetheless.
+	want := []string{
+		"internal/testdata/build_errors.proto:8:1: api-linter::parse-error (error)",
+		"internal/testdata/build_errors.proto:13:1: api-linter::parse-error (error)",
+	}
+	var got []string
+	linted := false
+	for _, r := range responses {
+		if r.FilePath == "internal/testdata/dummy.proto" {
+			linted = true
+			continue
 		}
-		actualLines[idx] = line
+		for _, p := range r.Problems {
+			got = append(got, fmt.Sprintf("%s:%d:%d: %s (%s)", r.FilePath, p.Location.Start.Line, p.Location.Start.Column, p.RuleID, p.Category))
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected errors: diff (-want +got):\n%s", diff)
 	}
-	if diff := cmp.Diff(expected, actualLines); diff != "" {
-		t.Fatalf("unexpected errors: diff (-want +got):\n%s", diff)
+	if !linted {
+		t.Errorf("output %s has no response for dummy.proto", out)
 	}
 }
 
@@ -203,6 +539,60 @@ func TestExitStatusForLintFailure(t *testing.T) {
 	}
 }
 
+func TestBuildErrors_Imports(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"broken.proto":   "syntax = \"proto3\";\npackage acme;\nmessage Broken {\n  string name = 1\n}\n",
+		"importer.proto": "syntax = \"proto3\";\npackage acme;\nimport \"broken.proto\";\nmessage Importer {\n  Broken broken = 1;\n}\n",
+		"good.proto":     "syntax = \"proto3\";\npackage acme;\nmessage Good {}\n",
+	}
+	for name, content := range files {
+		if err := writeFile(filepath.Join(tempDir, name), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	configPath := filepath.Join(tempDir, "config.yaml")
+	if err := writeFile(configPath, "- disabled_rules: ['api-linter::parse-error']\n"); err != nil {
+		t.Fatal(err)
+	}
+	outPath := filepath.Join(tempDir, "out.yaml")
+	args := []string{"-I=" + tempDir, "-o=" + outPath, "broken.proto", "importer.proto", "good.proto"}
+
+	// The error in the import is reported once, and the file that parses is
+	// linted nonetheless.
+	if err := runCLI(args); !errors.Is(err, ErrParseFailure) {
+		t.Fatalf("runCLI got error %v, but want %v", err, ErrParseFailure)
+	}
+	out, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(string(out), "rule_id: api-linter::parse-error"); got != 1 {
+		t.Errorf("got %d parse errors, but want 1:\n%s", got, out)
+	}
+	for _, want := range []string{"file_path: broken.proto", "file_path: good.proto"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("output has no %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(string(out), "file_path: importer.proto") {
+		t.Errorf("output should not lint importer.proto:\n%s", out)
+	}
+
+	// Parse errors disabled by the configs are neither reported nor fail
+	// the run.
+	if err := runCLI(append(args, "--config="+configPath)); err != nil {
+		t.Fatalf("runCLI got error %v, but want none", err)
+	}
+	out, err = os.ReadFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(out), "api-linter::parse-error") {
+		t.Errorf("parse errors should be disabled by the config:\n%s", out)
+	}
+}
+
 func runLinter(t *testing.T, protoContent, configContent string) string {
 	_, result := runLinterWithFailureStatus(t, protoContent, configContent, []string{})
 	return result
@@ -265,3 +655,10 @@ func writeFile(path, content string) error {
 	}
 	return os.WriteFile(path, []byte(content), 0o644)
 }
+
+func TestVersion_DoesNotLoadPlugins(t *testing.T) {
+	args := []string{"--version", "--plugin=" + filepath.Join(t.TempDir(), "missing-plugin")}
+	if err := runCLI(args); err != nil {
+		t.Errorf("runCLI(%v) returned error %v, but want the version", args, err)
+	}
+}
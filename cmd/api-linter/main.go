@@ -17,9 +17,11 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 
+	"github.com/aep-dev/api-linter/internal"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/rules"
 )
@@ -43,7 +45,22 @@ func main() {
 
 func runCLI(args []string) error {
 	c := newCli(args)
-	return c.lint(globalRules, globalConfigs)
+	// Print version and exit if asked, before loading any plugin.
+	if c.VersionFlag {
+		fmt.Printf("api-linter %s\n", internal.Version)
+		return nil
+	}
+	rules, err := c.withPlugins(globalRules)
+	if err != nil {
+		return err
+	}
+	switch c.Command {
+	case explainCommand:
+		return c.explain(rules)
+	case explainEnablementCommand:
+		return c.explainEnablement(rules, globalConfigs)
+	}
+	return c.lint(rules, globalConfigs)
 }
 
 // Enable all rules by default.
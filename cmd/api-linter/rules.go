@@ -5,15 +5,15 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 
+	"github.com/aep-dev/api-linter/docs"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/olekukonko/tablewriter"
 )
 
 type (
-	listedRule struct {
-		Name lint.RuleName
-	}
+	listedRule        lint.RuleMetadata
 	listedRules       []listedRule
 	listedRulesByName []listedRule
 )
@@ -25,11 +25,20 @@ func (a listedRulesByName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (r listedRules) printSummaryTable() ([]byte, error) {
 	var buf bytes.Buffer
 	table := tablewriter.NewWriter(&buf)
-	table.SetHeader([]string{"Rule Name"})
+	table.SetHeader([]string{"Rule Name", "AEP", "Type", "Fixable", "Default Enabled", "Description"})
 	table.SetCaption(true, fmt.Sprintf("Total Rules: %d", len(r)))
 	for _, rule := range r {
+		aep := ""
+		if rule.AEP != 0 {
+			aep = strconv.Itoa(rule.AEP)
+		}
 		table.Append([]string{
 			string(rule.Name),
+			aep,
+			rule.Type,
+			strconv.FormatBool(rule.Fixable),
+			strconv.FormatBool(rule.DefaultEnabled),
+			rule.Description,
 		})
 	}
 	table.Render()
@@ -37,12 +46,41 @@ func (r listedRules) printSummaryTable() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func outputRules(formatType string) error {
+// ruleFilter selects the rules to list.
+type ruleFilter struct {
+	// AEP selects the rules of an AEP, if not zero.
+	AEP int
+	// Type selects the rules of a type, if not empty.
+	Type string
+}
+
+func (f ruleFilter) matches(r listedRule) bool {
+	return (f.AEP == 0 || r.AEP == f.AEP) && (f.Type == "" || r.Type == f.Type)
+}
+
+// describeRule returns the metadata of a rule, completed with the
+// embedded rule documentation and the doc URL template, if any.
+func describeRule(rule lint.ProtoRule, docURLTemplate string) listedRule {
+	m := lint.GetRuleMetadata(rule)
+	m.DocURL = lint.RuleDocURL(rule, docURLTemplate)
+	if m.Description == "" {
+		m.Description = docs.RuleSummary(string(m.Name))
+	}
+	if m.AEPTitle == "" && m.AEP != 0 {
+		m.AEPTitle = docs.AEPTitle(m.AEP)
+	}
+	return listedRule(m)
+}
+
+func outputRules(registry lint.RuleRegistry, filter ruleFilter, docURLTemplate, formatType string) error {
+	if _, err := lint.ParseRuleType(filter.Type); err != nil {
+		return err
+	}
 	rules := listedRules{}
-	for id := range globalRules {
-		rules = append(rules, listedRule{
-			Name: id,
-		})
+	for _, rule := range registry {
+		if r := describeRule(rule, docURLTemplate); filter.matches(r) {
+			rules = append(rules, r)
+		}
 	}
 
 	sort.Sort(listedRulesByName(rules))
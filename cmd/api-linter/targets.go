@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// expandTargets replaces the lint targets with the proto files they name,
+// and adds the import paths of the buf workspaces containing them after
+// the ones given explicitly.
+func (c *cli) expandTargets() error {
+	// The current directory is always an import path; others are given.
+	explicitImports := len(c.ProtoImportPaths) > 1
+	files, importPaths, err := expandTargets(c.ProtoFiles, c.ExcludePatterns, explicitImports)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no file to lint")
+	}
+	for _, path := range importPaths {
+		if !slices.Contains(c.ProtoImportPaths, path) {
+			// The current directory always comes last.
+			i := max(len(c.ProtoImportPaths)-1, 0)
+			c.ProtoImportPaths = slices.Insert(c.ProtoImportPaths, i, path)
+		}
+	}
+	// Files are resolved against the current directory before the import
+	// paths, unless they are absolute, so that the files of a module are
+	// named relative to its root, as its imports name them.
+	for i, f := range files {
+		if slices.ContainsFunc(importPaths, func(dir string) bool { return within(dir, f) }) {
+			if files[i], err = filepath.Abs(f); err != nil {
+				return err
+			}
+		}
+	}
+	c.ProtoFiles = files
+	return nil
+}
+
+// expandTargets returns the proto files named by the targets, which may be
+// files, directories, which are walked recursively, `dir/...` patterns, or
+// doublestar globs. Files matching any of the exclude patterns are skipped,
+// as are the files found in a buf workspace which buf does not build.
+//
+// It also returns the import paths of the buf workspaces containing the
+// files: the roots of their modules, and their dependencies in the buf
+// module cache. If explicitImports is set, the files given explicitly are
+// expected to be found in the given import paths, and their workspaces are
+// not looked up.
+func expandTargets(targets, excludes []string, explicitImports bool) (files, importPaths []string, err error) {
+	for _, pattern := range excludes {
+		if !doublestar.ValidatePattern(pattern) {
+			return nil, nil, fmt.Errorf("invalid exclude pattern %q", pattern)
+		}
+	}
+	excluded := func(path string) bool {
+		path = filepath.ToSlash(filepath.Clean(path))
+		for _, pattern := range excludes {
+			if matched, _ := doublestar.Match(pattern, path); matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	workspaces := newBufWorkspaces()
+	workspace := func(path string) (*bufWorkspace, string, error) {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, "", err
+		}
+		ws, err := workspaces.find(filepath.Dir(abs))
+		return ws, abs, err
+	}
+	seen := map[string]bool{}
+	// The files whose workspaces provide import paths.
+	var resolve []string
+	add := func(path string, found bool) error {
+		if seen[path] || excluded(path) {
+			return nil
+		}
+		if found {
+			ws, abs, err := workspace(path)
+			if err != nil {
+				return err
+			}
+			if ws != nil && !ws.builds(abs) {
+				return nil
+			}
+		}
+		seen[path] = true
+		files = append(files, path)
+		if found || !explicitImports {
+			resolve = append(resolve, path)
+		}
+		return nil
+	}
+	for _, target := range targets {
+		dir, recursive := strings.CutSuffix(target, "...")
+		if recursive && (dir == "" || strings.HasSuffix(dir, "/") || strings.HasSuffix(dir, string(filepath.Separator))) {
+			target = filepath.Clean(dir + ".")
+		}
+		var matches []string
+		switch info, statErr := os.Stat(target); {
+		case statErr == nil && info.IsDir():
+			matches, err = walkProtoFiles(target, excluded)
+		case statErr != nil && strings.ContainsAny(target, "*?[{"):
+			matches, err = globProtoFiles(target)
+		default:
+			// Files, including missing ones, are reported by the parser.
+			if err := add(target, false); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(matches) == 0 {
+			return nil, nil, fmt.Errorf("no proto files match %q", target)
+		}
+		for _, m := range matches {
+			if err := add(m, true); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, nil, err
+	}
+	addImportPath := func(dir string) {
+		// Paths within the current directory are kept relative.
+		if rel, err := filepath.Rel(wd, dir); err == nil && filepath.IsLocal(rel) {
+			dir = rel
+		}
+		if !slices.Contains(importPaths, dir) {
+			importPaths = append(importPaths, dir)
+		}
+	}
+	for _, f := range resolve {
+		ws, _, err := workspace(f)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ws == nil {
+			continue
+		}
+		for _, m := range ws.modules {
+			addImportPath(m.root)
+		}
+		for _, dep := range ws.deps {
+			addImportPath(dep)
+		}
+	}
+	return files, importPaths, nil
+}
+
+// walkProtoFiles returns the proto files in a directory and its
+// subdirectories, skipping hidden and excluded directories.
+func walkProtoFiles(dir string, excluded func(string) bool) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dir && (strings.HasPrefix(d.Name(), ".") || excluded(path)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, ".proto") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+// globProtoFiles returns the proto files matching a doublestar glob.
+func globProtoFiles(pattern string) ([]string, error) {
+	matches, err := doublestar.FilepathGlob(pattern, doublestar.WithFilesOnly())
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	var files []string
+	for _, m := range matches {
+		if strings.HasSuffix(m, ".proto") {
+			files = append(files, m)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandTargets(t *testing.T) {
+	t.Chdir(t.TempDir())
+	for _, path := range []string{
+		"a.proto",
+		"apis/buf.yaml",
+		"apis/foo/v1/foo.proto",
+		"apis/foo/v1/README.md",
+		"apis/bar/v1/bar.proto",
+		"apis/vendor/dep/dep.proto",
+		"apis/.hidden/hidden.proto",
+		"empty/README.md",
+	} {
+		if err := writeFile(path, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		targets   []string
+		excludes  []string
+		wantFiles []string
+		wantRoots []string
+	}{
+		{
+			name:      "File",
+			targets:   []string{"a.proto"},
+			wantFiles: []string{"a.proto"},
+		},
+		{
+			name:      "MissingFile",
+			targets:   []string{"missing.proto"},
+			wantFiles: []string{"missing.proto"},
+		},
+		{
+			name:    "Directory",
+			targets: []string{"apis"},
+			wantFiles: []string{
+				filepath.FromSlash("apis/bar/v1/bar.proto"),
+				filepath.FromSlash("apis/foo/v1/foo.proto"),
+				filepath.FromSlash("apis/vendor/dep/dep.proto"),
+			},
+			wantRoots: []string{"apis"},
+		},
+		{
+			name:     "Recursive",
+			targets:  []string{"./..."},
+			excludes: []string{"**/vendor/**"},
+			wantFiles: []string{
+				"a.proto",
+				filepath.FromSlash("apis/bar/v1/bar.proto"),
+				filepath.FromSlash("apis/foo/v1/foo.proto"),
+			},
+			wantRoots: []string{"apis"},
+		},
+		{
+			name:      "Glob",
+			targets:   []string{"apis/**/foo.proto", "apis/foo/v1/foo.proto"},
+			wantFiles: []string{filepath.FromSlash("apis/foo/v1/foo.proto")},
+			wantRoots: []string{"apis"},
+		},
+		{
+			name:      "ExcludedFile",
+			targets:   []string{"a.proto", "apis/bar/..."},
+			excludes:  []string{"a.proto"},
+			wantFiles: []string{filepath.FromSlash("apis/bar/v1/bar.proto")},
+			wantRoots: []string{"apis"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files, roots, err := expandTargets(test.targets, test.excludes, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.wantFiles, files); diff != "" {
+				t.Errorf("expandTargets() files mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantRoots, roots); diff != "" {
+				t.Errorf("expandTargets() roots mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		name     string
+		targets  []string
+		excludes []string
+	}{
+		{"EmptyDirectory", []string{"empty"}, nil},
+		{"NoMatch", []string{"apis/**/missing.proto"}, nil},
+		{"InvalidExclude", []string{"a.proto"}, []string{"[a"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if _, _, err := expandTargets(test.targets, test.excludes, false); err == nil {
+				t.Errorf("expandTargets() got no error, but want one")
+			}
+		})
+	}
+}
+
+func TestLintModule(t *testing.T) {
+	t.Chdir(t.TempDir())
+	files := map[string]string{
+		"apis/buf.yaml": "version: v1\n",
+		"apis/foo/v1/foo.proto": `syntax = "proto3";
+package foo.v1;
+import "foo/v1/book.proto";
+service Library {
+  rpc GetBook(Book) returns (Book);
+}
+`,
+		"apis/foo/v1/book.proto": `syntax = "proto3";
+package foo.v1;
+message Book {}
+`,
+		"apis/vendor/bad.proto": "not a proto file",
+	}
+	for path, content := range files {
+		if err := writeFile(path, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := runCLI([]string{"-o=out.yaml", "--set-exit-status", "--exclude=**/vendor/**", "apis/..."})
+	if !errors.Is(err, ExitForLintFailure) {
+		t.Fatalf("runCLI got error %v, but want %v", err, ExitForLintFailure)
+	}
+	out, err := os.ReadFile("out.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"file_path: foo/v1/foo.proto", "file_path: foo/v1/book.proto", "GetBookRequest"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("output %q does not contain %q", out, want)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// The protoc plugin `protoc-gen-aep-lint` checks the files protoc compiles
+// against the AEP rules, using the include paths of the protoc invocation.
+//
+// The plugin parameter is a comma-separated list of options:
+//
+//   - config=<path>: the linter config file, relative to the directory
+//     protoc runs in.
+//   - enable_rule=<rule>, disable_rule=<rule>: enable or disable a rule.
+//     May be given multiple times.
+//   - report=<name>: write the linting results to the given file of the
+//     output directory, rather than failing on problems.
+//   - format=<yaml|json>: the format of the report. YAML is the default.
+//
+// For example:
+//
+//	protoc --aep-lint_out=. --aep-lint_opt=config=api-linter.yaml library.proto
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/aep-dev/api-linter/lint"
+	"github.com/aep-dev/api-linter/rules"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+	"gopkg.in/yaml.v2"
+)
+
+func main() {
+	if err := run(os.Stdin, os.Stdout); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// run reads a code generator request and writes the response.
+func run(r io.Reader, w io.Writer) error {
+	in, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(in, req); err != nil {
+		return err
+	}
+	out, err := proto.Marshal(generate(req))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// generate lints the files to generate of a request. The response has the
+// report file, if one is requested, or else an error listing the problems,
+// if any.
+func generate(req *pluginpb.CodeGeneratorRequest) *pluginpb.CodeGeneratorResponse {
+	resp := &pluginpb.CodeGeneratorResponse{
+		SupportedFeatures: proto.Uint64(uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)),
+	}
+	p, err := parseParams(req.GetParameter())
+	if err != nil {
+		resp.Error = proto.String(err.Error())
+		return resp
+	}
+	// Rules that fail still leave the problems of the others to report.
+	responses, lintErr := p.lint(req)
+	if lintErr != nil && responses == nil {
+		resp.Error = proto.String(lintErr.Error())
+		return resp
+	}
+
+	if p.report != "" {
+		marshal := yaml.Marshal
+		if p.format == "json" {
+			marshal = json.Marshal
+		}
+		content, err := marshal(responses)
+		if err != nil {
+			resp.Error = proto.String(err.Error())
+			return resp
+		}
+		resp.File = append(resp.File, &pluginpb.CodeGeneratorResponse_File{
+			Name:    proto.String(p.report),
+			Content: proto.String(string(content)),
+		})
+		if lintErr != nil {
+			resp.Error = proto.String(lintErr.Error())
+		}
+		return resp
+	}
+	var errs []string
+	if lintErr != nil {
+		errs = append(errs, lintErr.Error())
+	}
+	var problems []string
+	for _, r := range responses {
+		for _, problem := range r.Problems {
+			problems = append(problems, formatProblem(r.FilePath, problem))
+		}
+	}
+	if len(problems) > 0 {
+		errs = append(errs, fmt.Sprintf("found problems during linting:\n%s", strings.Join(problems, "\n")))
+	}
+	if len(errs) > 0 {
+		resp.Error = proto.String(strings.Join(errs, "\n"))
+	}
+	return resp
+}
+
+// params are the options of the plugin parameter.
+type params struct {
+	configPath    string
+	enabledRules  []string
+	disabledRules []string
+	report        string
+	format        string
+}
+
+// parseParams parses a plugin parameter, such as
+// "config=api-linter.yaml,disable_rule=core::0131::http-body".
+func parseParams(parameter string) (*params, error) {
+	p := &params{}
+	for _, option := range strings.Split(parameter, ",") {
+		if option == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(option, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid option %q: want key=value", option)
+		}
+		switch key {
+		case "config":
+			p.configPath = value
+		case "enable_rule":
+			p.enabledRules = append(p.enabledRules, value)
+		case "disable_rule":
+			p.disabledRules = append(p.disabledRules, value)
+		case "report":
+			p.report = value
+		case "format":
+			if value != "yaml" && value != "json" {
+				return nil, fmt.Errorf("unsupported format %q: want yaml or json", value)
+			}
+			p.format = value
+		default:
+			return nil, fmt.Errorf("unknown option %q", key)
+		}
+	}
+	return p, nil
+}
+
+// lint lints the files to generate of a request with the AEP rules, along
+// with the custom rules of the config.
+func (p *params) lint(req *pluginpb.CodeGeneratorRequest) ([]lint.Response, error) {
+	registry := lint.NewRuleRegistry()
+	if err := rules.Add(registry); err != nil {
+		return nil, err
+	}
+	configs := lint.Configs{}
+	if p.configPath != "" {
+		var err error
+		if configs, err = lint.ReadConfigsFromFile(p.configPath); err != nil {
+			return nil, err
+		}
+		if err := rules.AddCustomRules(registry, configs); err != nil {
+			return nil, fmt.Errorf("invalid config %s:\n%w", p.configPath, err)
+		}
+	}
+	if len(p.enabledRules) > 0 {
+		configs = append(configs, lint.Config{EnabledRules: p.enabledRules})
+	}
+	if len(p.disabledRules) > 0 {
+		configs = append(configs, lint.Config{DisabledRules: p.disabledRules})
+	}
+	if err := configs.Validate(registry); err != nil {
+		return nil, fmt.Errorf("invalid config:\n%w", err)
+	}
+
+	// protoc sends the files to generate along with all of their imports.
+	files, err := desc.CreateFileDescriptors(req.GetProtoFile())
+	if err != nil {
+		return nil, err
+	}
+	var targets []*desc.FileDescriptor
+	for _, name := range req.GetFileToGenerate() {
+		f, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("file to generate %q is not in the request", name)
+		}
+		targets = append(targets, f)
+	}
+	return lint.New(registry, configs).LintProtos(targets...)
+}
+
+// formatProblem formats a problem as "file:line:column: rule: message", or
+// as "file: element: rule: message" if its position is unknown.
+func formatProblem(path string, p lint.Problem) string {
+	loc := p.Location
+	if loc == nil {
+		loc = p.Descriptor.GetSourceInfo()
+	}
+	if span := loc.GetSpan(); len(span) >= 3 {
+		path = fmt.Sprintf("%s:%d:%d", path, span[0]+1, span[1]+1)
+	} else if element := p.Element(); element != "" {
+		path = fmt.Sprintf("%s: %s", path, element)
+	}
+	return fmt.Sprintf("%s: %s: %s", path, p.RuleID, p.Message)
+}
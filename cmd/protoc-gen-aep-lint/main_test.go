@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// newRequest returns the request protoc sends to generate library.proto.
+func newRequest(t *testing.T, parameter string) *pluginpb.CodeGeneratorRequest {
+	t.Helper()
+	files := map[string]string{
+		"book.proto": `syntax = "proto3";
+package acme.library.v1;
+message Book {}
+`,
+		"library.proto": `syntax = "proto3";
+package acme.library.v1;
+import "book.proto";
+service Library {
+  rpc GetBook(Book) returns (Book);
+}
+`,
+	}
+	p := protoparse.Parser{
+		Accessor:              protoparse.FileContentsFromMap(files),
+		IncludeSourceCodeInfo: true,
+	}
+	fds, err := p.ParseFiles("library.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"library.proto"},
+		Parameter:      proto.String(parameter),
+		ProtoFile: []*dpb.FileDescriptorProto{
+			fds[0].GetDependencies()[0].AsFileDescriptorProto(),
+			fds[0].AsFileDescriptorProto(),
+		},
+	}
+	return req
+}
+
+func TestRun(t *testing.T) {
+	in, err := proto.Marshal(newRequest(t, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := run(bytes.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(out.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"found problems during linting",
+		"library.proto:5:15: core::0131::request-message-name:",
+	} {
+		if !strings.Contains(resp.GetError(), want) {
+			t.Errorf("response error %q does not contain %q", resp.GetError(), want)
+		}
+	}
+	if strings.Contains(resp.GetError(), "book.proto") {
+		t.Errorf("response error %q has problems of an imported file", resp.GetError())
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		parameter string
+		wantError string
+		wantFile  string
+	}{
+		{
+			name:      "Disabled",
+			parameter: "disable_rule=core::0127,disable_rule=core::0131,disable_rule=core::0191,disable_rule=core::0192",
+		},
+		{
+			name:      "Report",
+			parameter: "report=lint.yaml",
+			wantFile:  "rule_id: core::0131::request-message-name",
+		},
+		{
+			name:      "JSONReport",
+			parameter: "report=lint.json,format=json",
+			wantFile:  `"rule_id":"core::0131::request-message-name"`,
+		},
+		{
+			name:      "UnknownOption",
+			parameter: "colour=blue",
+			wantError: `unknown option "colour"`,
+		},
+		{
+			name:      "UnknownRule",
+			parameter: "enable_rule=core::9999::unknown",
+			wantError: "core::9999::unknown",
+		},
+		{
+			name:      "MissingConfig",
+			parameter: "config=missing.yaml",
+			wantError: "missing.yaml",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := generate(newRequest(t, test.parameter))
+			if test.wantError == "" && resp.GetError() != "" || !strings.Contains(resp.GetError(), test.wantError) {
+				t.Errorf("generate() got error %q, but want %q", resp.GetError(), test.wantError)
+			}
+			switch {
+			case test.wantFile == "" && len(resp.GetFile()) > 0:
+				t.Errorf("generate() got files %v, but want none", resp.GetFile())
+			case test.wantFile != "" && len(resp.GetFile()) != 1:
+				t.Errorf("generate() got files %v, but want one report", resp.GetFile())
+			case test.wantFile != "" && !strings.Contains(resp.GetFile()[0].GetContent(), test.wantFile):
+				t.Errorf("report %q does not contain %q", resp.GetFile()[0].GetContent(), test.wantFile)
+			}
+		})
+	}
+}
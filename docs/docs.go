@@ -0,0 +1,105 @@
+// Package docs embeds the rule documentation, so that the linter can
+// describe its rules without network access.
+package docs
+
+import (
+	"bytes"
+	"embed"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed rules/*/*.md
+var ruleFiles embed.FS
+
+// ruleDoc is the documentation of a rule.
+type ruleDoc struct {
+	summary string
+	body    string
+}
+
+var (
+	ruleDocs  = map[string]ruleDoc{}
+	aepTitles = map[int]string{}
+)
+
+func init() {
+	paths, err := fs.Glob(ruleFiles, "rules/*/*.md")
+	if err != nil {
+		panic(err)
+	}
+	for _, p := range paths {
+		b, err := ruleFiles.ReadFile(p)
+		if err != nil {
+			panic(err)
+		}
+		frontMatter, body := splitFrontMatter(b)
+		if path.Base(p) == "index.md" {
+			aep, err := strconv.Atoi(path.Base(path.Dir(p)))
+			if err == nil {
+				aepTitles[aep] = title(body)
+			}
+			continue
+		}
+		var fm struct {
+			Rule struct {
+				Name    []string `yaml:"name"`
+				Summary string   `yaml:"summary"`
+			} `yaml:"rule"`
+		}
+		if err := yaml.Unmarshal(frontMatter, &fm); err != nil || len(fm.Rule.Name) == 0 {
+			continue
+		}
+		ruleDocs[strings.Join(fm.Rule.Name, "::")] = ruleDoc{
+			summary: strings.Join(strings.Fields(fm.Rule.Summary), " "),
+			body:    strings.TrimSpace(string(body)),
+		}
+	}
+}
+
+// RuleSummary returns the one-sentence summary of a rule, or an empty
+// string if the rule is not documented.
+func RuleSummary(name string) string {
+	return ruleDocs[name].summary
+}
+
+// RuleDoc returns the Markdown documentation of a rule, without its front
+// matter, or an empty string if the rule is not documented.
+func RuleDoc(name string) string {
+	return ruleDocs[name].body
+}
+
+// AEPTitle returns the title of the rules documentation of an AEP, such as
+// "Standard methods: Get", or an empty string if there is none.
+func AEPTitle(aep int) string {
+	return aepTitles[aep]
+}
+
+// splitFrontMatter splits a Markdown file into its YAML front matter and
+// its body.
+func splitFrontMatter(b []byte) (frontMatter, body []byte) {
+	const delimiter = "---\n"
+	if !bytes.HasPrefix(b, []byte(delimiter)) {
+		return nil, b
+	}
+	rest := b[len(delimiter):]
+	end := bytes.Index(rest, []byte("\n"+delimiter))
+	if end < 0 {
+		return nil, b
+	}
+	return rest[:end+1], rest[end+1+len(delimiter):]
+}
+
+// title returns the first top-level heading of a Markdown body.
+func title(body []byte) string {
+	for _, line := range strings.Split(string(body), "\n") {
+		if t, ok := strings.CutPrefix(line, "# "); ok {
+			return strings.TrimSpace(t)
+		}
+	}
+	return ""
+}
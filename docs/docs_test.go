@@ -0,0 +1,49 @@
+package docs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleSummary(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		want string
+	}{
+		{"core::0131::http-body", "Get methods must not have an HTTP body."},
+		{"core::0131::unknown", ""},
+	} {
+		if got := RuleSummary(test.name); got != test.want {
+			t.Errorf("RuleSummary(%q) got %q, but want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestAEPTitle(t *testing.T) {
+	for _, test := range []struct {
+		aep  int
+		want string
+	}{
+		{131, "Standard methods: Get"},
+		{1, ""},
+	} {
+		if got := AEPTitle(test.aep); got != test.want {
+			t.Errorf("AEPTitle(%d) got %q, but want %q", test.aep, got, test.want)
+		}
+	}
+}
+
+func TestRuleDoc(t *testing.T) {
+	doc := RuleDoc("core::0131::http-body")
+	for _, want := range []string{"# Get methods: No HTTP body", "## Examples", "## Disabling"} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("RuleDoc got %q, but want it to contain %q", doc, want)
+		}
+	}
+	if strings.HasPrefix(doc, "---") {
+		t.Errorf("RuleDoc got %q, but want it without front matter", doc)
+	}
+	if got := RuleDoc("core::0131::unknown"); got != "" {
+		t.Errorf("RuleDoc got %q for an unknown rule, but want an empty string", got)
+	}
+}
@@ -29,6 +29,7 @@ import (
 // The set of checkers that are run on every discovered rule.
 var checkers = []func(aep int, name string) []error{
 	checkRuleDocumented,
+	checkRuleFixable,
 	checkRuleName,
 	checkRuleRegistered,
 }
@@ -52,6 +53,7 @@ func main() {
 		for _, exempt := range []func(path string, info os.FileInfo) bool{
 			func(_ string, i os.FileInfo) bool { return i.IsDir() },
 			func(p string, _ os.FileInfo) bool { return strings.Contains(p, "/internal/") },
+			func(p string, _ os.FileInfo) bool { return strings.Contains(p, "/testdata/") },
 			func(p string, _ os.FileInfo) bool { return p == "rules/rules.go" },
 			func(p string, _ os.FileInfo) bool { return strings.HasSuffix(p, "_test.go") },
 			func(p string, _ os.FileInfo) bool { return aepIndex.MatchString(p) },
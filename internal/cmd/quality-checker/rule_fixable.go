@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/stoewer/go-strcase"
+)
+
+// checkRuleFixable checks that a rule declares itself fixable if, and only
+// if, its problems suggest fixes.
+func checkRuleFixable(aep int, name string) []error {
+	path := fmt.Sprintf("rules/aep%04d/%s.go", aep, strcase.SnakeCase(name))
+
+	// Read in the file.
+	contentsBytes, err := os.ReadFile(path)
+	if err != nil {
+		return []error{err}
+	}
+	contents := string(contentsBytes)
+
+	suggests := suggestionRegexp.MatchString(contents)
+	fixable := strings.Contains(contents, "Fixable: true")
+	if suggests && !fixable {
+		return []error{fmt.Errorf("rule suggests fixes, but does not set Metadata.Fixable: %s", path)}
+	}
+	if !suggests && fixable {
+		return []error{fmt.Errorf("rule sets Metadata.Fixable, but does not suggest fixes: %s", path)}
+	}
+	return nil
+}
+
+// suggestionRegexp matches the problems with suggestions, including those
+// of the descutil helpers which suggest fixes.
+var suggestionRegexp = regexp.MustCompile(`Suggestion:|descutil\.(LintSingular[A-Za-z]*Field|LintFieldMask|LintFieldPresentAndSingularString|LintMethodHasMatching[A-Za-z]+Name)\b`)
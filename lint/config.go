@@ -15,14 +15,17 @@
 package lint
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/bmatcuk/doublestar/v4"
+	"github.com/jhump/protoreflect/desc"
 	"gopkg.in/yaml.v2"
 )
 
@@ -31,17 +34,63 @@ type Configs []Config
 
 // Config stores rule configurations for certain files
 // that the file path must match any of the included paths
-// but none of the excluded ones.
+// but none of the excluded ones. The file path is the name of the
+// file relative to its import path, wherever the config file is.
+//
+// A config may also be restricted to proto packages, and to the elements
+// whose fully qualified names match its element patterns. Package and
+// element patterns use dots as separators, as in "acme.internal.**", and
+// the element patterns are matched against each problem's descriptor and
+// the descriptors containing it.
+//
+// A config may extend other config files or named presets. The extended
+// configs are resolved when reading a config file, and take effect before
+// the rules of the config that extends them.
 type Config struct {
-	IncludedPaths []string `json:"included_paths" yaml:"included_paths"`
-	ExcludedPaths []string `json:"excluded_paths" yaml:"excluded_paths"`
-	EnabledRules  []string `json:"enabled_rules" yaml:"enabled_rules"`
-	DisabledRules []string `json:"disabled_rules" yaml:"disabled_rules"`
+	Extends       []string `json:"extends,omitempty" yaml:"extends,omitempty"`
+	IncludedPaths []string `json:"included_paths,omitempty" yaml:"included_paths,omitempty"`
+	ExcludedPaths []string `json:"excluded_paths,omitempty" yaml:"excluded_paths,omitempty"`
+	EnabledRules  []string `json:"enabled_rules,omitempty" yaml:"enabled_rules,omitempty"`
+	DisabledRules []string `json:"disabled_rules,omitempty" yaml:"disabled_rules,omitempty"`
+
+	IncludedPackages []string `json:"included_packages,omitempty" yaml:"included_packages,omitempty"`
+	ExcludedPackages []string `json:"excluded_packages,omitempty" yaml:"excluded_packages,omitempty"`
+	IncludedElements []string `json:"included_elements,omitempty" yaml:"included_elements,omitempty"`
+	ExcludedElements []string `json:"excluded_elements,omitempty" yaml:"excluded_elements,omitempty"`
+
+	// RequireDisableReason requires every disable comment to give a reason,
+	// as in `(-- api-linter: rule=disabled reason="..." --)`.
+	RequireDisableReason bool `json:"require_disable_reason,omitempty" yaml:"require_disable_reason,omitempty"`
+	// RequireDisableExpiry requires every disable comment to give an expiry
+	// date, as in `(-- api-linter: rule=disabled until=2027-01-01 --)`.
+	RequireDisableExpiry bool `json:"require_disable_expiry,omitempty" yaml:"require_disable_expiry,omitempty"`
+
+	// RuleDocURI is the template of the rule documentation URLs of the
+	// problems, as described in RuleURLFromTemplate. The last config that
+	// sets it and applies to a problem's descriptor takes effect.
+	RuleDocURI string `json:"rule_doc_uri,omitempty" yaml:"rule_doc_uri,omitempty"`
+
+	// CustomRules declares rules written as CEL expressions.
+	CustomRules []CustomRule `json:"custom_rules,omitempty" yaml:"custom_rules,omitempty"`
+
+	// The file or preset the config was read from, if any.
+	source string
+
+	// The included paths of the configs extending this one, each of which
+	// must match as well as its own.
+	extendingIncludedPaths [][]string
 }
 
 // ReadConfigsFromFile reads Configs from a file.
 // It supports JSON(.json) and YAML(.yaml or .yml) files.
+//
+// Any `extends` entries are resolved, relative to the directory of the file
+// for file references, and replaced by the configs they refer to.
 func ReadConfigsFromFile(path string) (Configs, error) {
+	return readConfigsFromFile(path, map[string]bool{})
+}
+
+func readConfigsFromFile(path string, seen map[string]bool) (Configs, error) {
 	var parse func(io.Reader) (Configs, error)
 	switch filepath.Ext(path) {
 	case ".json":
@@ -59,61 +108,316 @@ func ReadConfigsFromFile(path string) (Configs, error) {
 	}
 	defer f.Close()
 
-	return parse(f)
+	configs, err := parse(f)
+	if err != nil {
+		return nil, err
+	}
+	for i := range configs {
+		configs[i].source = path
+	}
+	return resolveExtends(configs, path, seen)
+}
+
+// resolveExtends replaces the `extends` entries of the given configs, read
+// from the file at path, with the configs they refer to.
+func resolveExtends(configs Configs, path string, seen map[string]bool) (Configs, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if seen[abs] {
+		return nil, fmt.Errorf("reading Configs: cyclic `extends` of %q", path)
+	}
+	seen[abs] = true
+	defer delete(seen, abs)
+
+	resolved := Configs{}
+	for _, c := range configs {
+		for _, ext := range c.Extends {
+			if preset, ok := configPresets[ext]; ok {
+				for _, p := range preset {
+					p.source = "preset " + ext
+					resolved = append(resolved, p.scopedTo(c))
+				}
+				continue
+			}
+			if !filepath.IsAbs(ext) {
+				ext = filepath.Join(filepath.Dir(path), ext)
+			}
+			extended, err := readConfigsFromFile(ext, seen)
+			if err != nil {
+				return nil, fmt.Errorf("extending %q: %w", path, err)
+			}
+			for _, e := range extended {
+				resolved = append(resolved, e.scopedTo(c))
+			}
+		}
+		// A config that only extends others has nothing left to contribute,
+		// as its paths now scope the configs it extends.
+		if len(c.Extends) > 0 && !c.hasEffect() {
+			continue
+		}
+		c.Extends = nil
+		resolved = append(resolved, c)
+	}
+	return resolved, nil
+}
+
+// scopedTo returns the config restricted to the paths of the config that
+// extends it, in addition to its own.
+func (c Config) scopedTo(extending Config) Config {
+	c.ExcludedPaths = append(slices.Clone(c.ExcludedPaths), extending.ExcludedPaths...)
+	switch {
+	case len(extending.IncludedPaths) == 0:
+	case len(c.IncludedPaths) == 0:
+		c.IncludedPaths = extending.IncludedPaths
+	default:
+		c.extendingIncludedPaths = append(slices.Clone(c.extendingIncludedPaths), extending.IncludedPaths)
+	}
+	return c
 }
 
 // ReadConfigsJSON reads Configs from a JSON file.
+// Unknown keys are reported as an error.
 func ReadConfigsJSON(f io.Reader) (Configs, error) {
 	b, err := io.ReadAll(f)
 	if err != nil {
 		return nil, err
 	}
 	var c Configs
-	if err := json.Unmarshal(b, &c); err != nil {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&c); err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
 // ReadConfigsYAML reads Configs from a YAML(.yml or .yaml) file.
+// Unknown keys are reported as an error.
 func ReadConfigsYAML(f io.Reader) (Configs, error) {
 	b, err := io.ReadAll(f)
 	if err != nil {
 		return nil, err
 	}
 	var c Configs
-	if err := yaml.Unmarshal(b, &c); err != nil {
+	if err := yaml.UnmarshalStrict(b, &c); err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
 // IsRuleEnabled returns true if a rule is enabled by the configs.
+// Configs restricted to packages or elements do not apply, because only
+// the path of the file is known.
 func (configs Configs) IsRuleEnabled(rule string, path string) bool {
+	return configs.traceIsRuleEnabled(rule, ruleTarget{path: path}, nil)
+}
+
+// IsRuleEnabledForDescriptor returns true if a rule is enabled by the configs
+// for problems on the given descriptor.
+func (configs Configs) IsRuleEnabledForDescriptor(rule string, d desc.Descriptor) bool {
+	return configs.traceIsRuleEnabled(rule, descriptorTarget(d), nil)
+}
+
+// ruleTarget is what the configs are matched against.
+type ruleTarget struct {
+	path string
+	// The file, if known. Configs restricted to packages only apply when it is.
+	file *desc.FileDescriptor
+	// The descriptor, if known. When the file is known but not the
+	// descriptor, configs restricted to elements may apply.
+	element desc.Descriptor
+}
+
+func descriptorTarget(d desc.Descriptor) ruleTarget {
+	return ruleTarget{path: d.GetFile().GetName(), file: d.GetFile(), element: d}
+}
+
+func (t ruleTarget) String() string {
+	if t.element != nil {
+		if _, isFile := t.element.(*desc.FileDescriptor); !isFile {
+			return t.element.GetFullyQualifiedName()
+		}
+	}
+	return t.path
+}
+
+// targetMatch is the result of matching a config against a ruleTarget.
+type targetMatch int
+
+const (
+	noMatch targetMatch = iota
+	// The config applies to some elements of the file.
+	mayMatch
+	fullMatch
+)
+
+// traceIsRuleEnabled is IsRuleEnabled, reporting every config that affects
+// the outcome to the given tracer.
+//
+// When a config may only apply to some elements of the target file, it is
+// taken into account if it enables the rule, so that the rule runs and its
+// problems can be checked against the config one by one.
+func (configs Configs) traceIsRuleEnabled(rule string, t ruleTarget, trace enablementTracer) bool {
 	// Enabled by default if the rule does not belong to one of the default
 	// disabled groups. Otherwise, needs to be explicitly enabled.
-	enabled := !matchRule(rule, defaultDisabledRules...)
-	for _, c := range configs {
-		if c.matchPath(path) {
-			if matchRule(rule, c.DisabledRules...) {
-				enabled = false
+	enabled := true
+	if prefix := matchingRule(rule, defaultDisabledRules...); prefix != "" {
+		enabled = false
+		trace.step("default", fmt.Sprintf("%q is disabled by default", prefix), enabled)
+	} else {
+		trace.step("default", "enabled by default", enabled)
+	}
+	for i, c := range configs {
+		source := fmt.Sprintf("config[%d]", i)
+		if c.source != "" {
+			source += " from " + c.source
+		}
+		disabledBy := matchingRule(rule, c.DisabledRules...)
+		enabledBy := matchingRule(rule, c.EnabledRules...)
+		match := c.matchTarget(t)
+		if match == noMatch {
+			if disabledBy != "" || enabledBy != "" {
+				trace.step(source, fmt.Sprintf("does not apply to %q", t), enabled)
 			}
-			if matchRule(rule, c.EnabledRules...) {
+			continue
+		}
+		if match == mayMatch {
+			if enabledBy != "" {
 				enabled = true
+				trace.step(source, fmt.Sprintf("enabled_rules contains %q for some elements of %q", enabledBy, t), enabled)
 			}
+			continue
+		}
+		if disabledBy != "" {
+			enabled = false
+			trace.step(source, fmt.Sprintf("disabled_rules contains %q", disabledBy), enabled)
+		}
+		if enabledBy != "" {
+			enabled = true
+			trace.step(source, fmt.Sprintf("enabled_rules contains %q", enabledBy), enabled)
 		}
 	}
 
 	return enabled
 }
 
+// hasEffect returns true if the config enables or disables anything, or
+// declares custom rules.
+func (c Config) hasEffect() bool {
+	return len(c.EnabledRules) > 0 || len(c.DisabledRules) > 0 ||
+		c.RequireDisableReason || c.RequireDisableExpiry || c.RuleDocURI != "" ||
+		len(c.CustomRules) > 0
+}
+
+// ruleDocURLTemplate returns the rule documentation URL template of the last
+// config that sets one and applies to the descriptor, or an empty string.
+func (configs Configs) ruleDocURLTemplate(d desc.Descriptor) string {
+	template := ""
+	t := descriptorTarget(d)
+	for _, c := range configs {
+		if c.RuleDocURI != "" && c.matchTarget(t) == fullMatch {
+			template = c.RuleDocURI
+		}
+	}
+	return template
+}
+
+// ForPath returns the configs that apply to the given file path.
+// Configs restricted to packages or elements are included if they apply
+// to the path.
+func (configs Configs) ForPath(path string) Configs {
+	matched := Configs{}
+	for _, c := range configs {
+		if c.matchPath(path) {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
 func (c Config) matchPath(path string) bool {
 	if matchPath(path, c.ExcludedPaths...) {
 		return false
 	}
+	for _, included := range c.extendingIncludedPaths {
+		if !matchPath(path, included...) {
+			return false
+		}
+	}
 	return len(c.IncludedPaths) == 0 || matchPath(path, c.IncludedPaths...)
 }
 
+// hasElementCriteria returns true if any of the configs is restricted to
+// elements, so that whether a rule is enabled may differ between the
+// descriptors of a file.
+func (configs Configs) hasElementCriteria() bool {
+	for _, c := range configs {
+		if len(c.IncludedElements) > 0 || len(c.ExcludedElements) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Config) matchTarget(t ruleTarget) targetMatch {
+	if !c.matchPath(t.path) {
+		return noMatch
+	}
+	if len(c.IncludedPackages) > 0 || len(c.ExcludedPackages) > 0 {
+		if t.file == nil || !c.matchPackage(t.file.GetPackage()) {
+			return noMatch
+		}
+	}
+	if len(c.IncludedElements) > 0 || len(c.ExcludedElements) > 0 {
+		if t.file == nil {
+			return noMatch
+		}
+		if t.element == nil {
+			return mayMatch
+		}
+		if !c.matchElement(t.element) {
+			return noMatch
+		}
+	}
+	return fullMatch
+}
+
+func (c Config) matchPackage(pkg string) bool {
+	if matchPackage(pkg, c.ExcludedPackages...) {
+		return false
+	}
+	return len(c.IncludedPackages) == 0 || matchPackage(pkg, c.IncludedPackages...)
+}
+
+func matchPackage(pkg string, packagePatterns ...string) bool {
+	for _, pattern := range packagePatterns {
+		if matched, _ := doublestar.Match(elementPath(pattern), elementPath(pkg)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// matchElement returns true if the descriptor, or any descriptor containing
+// it, matches the included element patterns, and none matches the excluded
+// ones.
+func (c Config) matchElement(d desc.Descriptor) bool {
+	included := len(c.IncludedElements) == 0
+	for ; d != nil; d = d.GetParent() {
+		for _, pattern := range c.ExcludedElements {
+			if matchElement(pattern, d) {
+				return false
+			}
+		}
+		for _, pattern := range c.IncludedElements {
+			included = included || matchElement(pattern, d)
+		}
+	}
+	return included
+}
+
 func matchPath(path string, pathPatterns ...string) bool {
 	for _, pattern := range pathPatterns {
 		if matched, _ := doublestar.Match(pattern, path); matched {
@@ -124,9 +428,15 @@ func matchPath(path string, pathPatterns ...string) bool {
 }
 
 func matchRule(rule string, rulePrefixes ...string) bool {
+	return matchingRule(rule, rulePrefixes...) != ""
+}
+
+// matchingRule returns the first of the rule prefixes that matches the rule,
+// or an empty string if none does.
+func matchingRule(rule string, rulePrefixes ...string) string {
 	rule = strings.ToLower(rule)
-	for _, prefix := range rulePrefixes {
-		prefix = strings.ToLower(prefix)
+	for _, original := range rulePrefixes {
+		prefix := strings.ToLower(original)
 		prefix = strings.TrimSuffix(prefix, nameSeparator) // "core::" -> "core"
 		prefix = strings.TrimPrefix(prefix, nameSeparator) // "::http-body" -> "http-body"
 		if prefix == "all" ||
@@ -134,8 +444,8 @@ func matchRule(rule string, rulePrefixes ...string) bool {
 			strings.HasPrefix(rule, prefix+nameSeparator) || // e.g., "core" matches "core::http-body", but not "core-rules::http-body"
 			strings.HasSuffix(rule, nameSeparator+prefix) || // e.g., "http-body" matches "core::http-body", but not "core::google-http-body"
 			strings.Contains(rule, nameSeparator+prefix+nameSeparator) { // e.g., "http-body" matches "core::http-body::post", but not "core::google-http-body::post"
-			return true
+			return original
 		}
 	}
-	return false
+	return ""
 }
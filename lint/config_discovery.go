@@ -0,0 +1,73 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// configFileNames are the names of the config files that are discovered
+// automatically, in order of precedence within a single directory.
+var configFileNames = []string{
+	".api-linter.yaml",
+	".api-linter.yml",
+	".api-linter.json",
+}
+
+// FindConfigFiles returns the config files that apply to the file at the
+// given path, found by walking up from the directory containing the file.
+//
+// The walk stops at the root of a git repository or of the file system.
+// The returned files are ordered from the outermost to the nearest one, so
+// that reading them in order lets the nearest config win.
+func FindConfigFiles(path string) ([]string, error) {
+	return findFilesUp(path, configFileNames)
+}
+
+// findFilesUp returns the first of the given file names found in each
+// directory from the one containing the file at the given path up to the
+// root of a git repository or of the file system, ordered from the outermost
+// to the nearest one.
+func findFilesUp(path string, names []string) ([]string, error) {
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for {
+		for _, name := range names {
+			candidate := filepath.Join(dir, name)
+			if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+				files = append([]string{candidate}, files...)
+				break
+			}
+		}
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	return files, nil
+}
+
+// DiscoverConfigs returns the Configs that apply to the file at the given
+// path, merged from every config file found by FindConfigFiles.
+func DiscoverConfigs(path string) (Configs, error) {
+	files, err := FindConfigFiles(path)
+	if err != nil {
+		return nil, err
+	}
+	configs := Configs{}
+	for _, f := range files {
+		c, err := ReadConfigsFromFile(f)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, c...)
+	}
+	return configs, nil
+}
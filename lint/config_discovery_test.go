@@ -0,0 +1,83 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestDiscoverConfigs(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, ".git"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		".api-linter.yaml": `
+- disabled_rules: ['rule_a']
+`,
+		"apis/.api-linter.json": `[{"enabled_rules": ["rule_a"]}]`,
+		// A YAML file takes precedence over a JSON file in the same directory.
+		"apis/v1/.api-linter.yaml": `
+- disabled_rules: ['rule_b']
+`,
+		"apis/v1/.api-linter.json": `[{"disabled_rules": ["ignored"]}]`,
+	}
+	for name, content := range files {
+		if err := writeTestFile(filepath.Join(root, name), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want Configs
+	}{
+		{
+			"Root",
+			"a.proto",
+			Configs{{DisabledRules: []string{"rule_a"}}},
+		},
+		{
+			"Nested",
+			"apis/v1/book.proto",
+			Configs{
+				{DisabledRules: []string{"rule_a"}},
+				{EnabledRules: []string{"rule_a"}},
+				{DisabledRules: []string{"rule_b"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := DiscoverConfigs(filepath.Join(root, test.path))
+			if err != nil {
+				t.Fatalf("DiscoverConfigs returned error: %v", err)
+			}
+			if diff := cmp.Diff(test.want, got, cmpopts.IgnoreUnexported(Config{})); diff != "" {
+				t.Errorf("DiscoverConfigs mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindConfigFiles_StopsAtRepositoryRoot(t *testing.T) {
+	outer := t.TempDir()
+	if err := writeTestFile(filepath.Join(outer, ".api-linter.yaml"), "[]"); err != nil {
+		t.Fatal(err)
+	}
+	repo := filepath.Join(outer, "repo")
+	if err := os.MkdirAll(filepath.Join(repo, ".git"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	files, err := FindConfigFiles(filepath.Join(repo, "a.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("FindConfigFiles got %v, but want no files", files)
+	}
+}
@@ -0,0 +1,18 @@
+package lint
+
+// configPresets stores named Configs that a config file can extend, in
+// addition to other config files.
+//
+// Additional presets can be injected into this map.
+// Example: google_config_presets.go
+// package lint
+//
+//	func init() {
+//	  configPresets["google:strict"] = Configs{{EnabledRules: []string{"all"}}}
+//	}
+var configPresets = map[string]Configs{
+	// The rules that are enabled by default.
+	"aep:default": {},
+	// Every rule, including the ones that are disabled by default.
+	"aep:all": {{EnabledRules: []string{"all"}}},
+}
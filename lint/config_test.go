@@ -21,6 +21,10 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jhump/protoreflect/desc"
 )
 
 func TestRuleConfigs_IsRuleEnabled(t *testing.T) {
@@ -455,3 +459,219 @@ func createTempFile(t *testing.T, name, content string) string {
 	}
 	return filePath
 }
+
+func TestReadConfigsFromFileExtends(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"base.yaml": `
+- disabled_rules:
+    - 'rule_a'
+`,
+		"sub/child.yaml": `
+- extends:
+    - '../base.yaml'
+    - 'aep:all'
+  disabled_rules:
+    - 'rule_b'
+`,
+		"cycle_a.yaml": `
+- extends: ['cycle_b.yaml']
+`,
+		"cycle_b.yaml": `
+- extends: ['cycle_a.yaml']
+`,
+		"missing.yaml": `
+- extends: ['does-not-exist.yaml']
+`,
+	}
+	for name, content := range files {
+		if err := writeTestFile(filepath.Join(dir, name), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	configs, err := ReadConfigsFromFile(filepath.Join(dir, "sub/child.yaml"))
+	if err != nil {
+		t.Fatalf("ReadConfigsFromFile returned error: %v", err)
+	}
+	want := Configs{
+		{DisabledRules: []string{"rule_a"}},
+		{EnabledRules: []string{"all"}},
+		{DisabledRules: []string{"rule_b"}},
+	}
+	if diff := cmp.Diff(want, configs, cmpopts.IgnoreUnexported(Config{})); diff != "" {
+		t.Errorf("ReadConfigsFromFile mismatch (-want +got):\n%s", diff)
+	}
+
+	for _, name := range []string{"cycle_a.yaml", "missing.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ReadConfigsFromFile(filepath.Join(dir, name)); err == nil {
+				t.Errorf("ReadConfigsFromFile(%q) expects an error", name)
+			}
+		})
+	}
+}
+
+func TestReadConfigsFromFileScopedExtends(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"base.yaml": `
+- disabled_rules: ['rule_a']
+- included_paths: ['**/v1/**']
+  disabled_rules: ['rule_b']
+`,
+		// An entry with only extends and paths, which scope the extended
+		// configs.
+		"scoped.yaml": `
+- extends: ['base.yaml']
+  included_paths: ['a/**']
+  excluded_paths: ['a/gen/**']
+`,
+	}
+	for name, content := range files {
+		if err := writeTestFile(filepath.Join(dir, name), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	configs, err := ReadConfigsFromFile(filepath.Join(dir, "scoped.yaml"))
+	if err != nil {
+		t.Fatalf("ReadConfigsFromFile returned error: %v", err)
+	}
+
+	tests := []struct {
+		rule    string
+		path    string
+		enabled bool
+	}{
+		{"rule_a", "a/book.proto", false},
+		{"rule_a", "b/book.proto", true},
+		{"rule_a", "a/gen/book.proto", true},
+		{"rule_b", "a/v1/book.proto", false},
+		{"rule_b", "a/v2/book.proto", true},
+		{"rule_b", "b/v1/book.proto", true},
+		{"rule_b", "a/gen/v1/book.proto", true},
+	}
+	for _, test := range tests {
+		if got := configs.IsRuleEnabled(test.rule, test.path); got != test.enabled {
+			t.Errorf("IsRuleEnabled(%q, %q) got %v, but want %v", test.rule, test.path, got, test.enabled)
+		}
+	}
+}
+
+func TestConfigs_ForPath(t *testing.T) {
+	configs := Configs{
+		{DisabledRules: []string{"rule_a"}},
+		{IncludedPaths: []string{"a/**"}, DisabledRules: []string{"rule_b"}},
+		{ExcludedPaths: []string{"a/**"}, DisabledRules: []string{"rule_c"}},
+	}
+	want := Configs{configs[0], configs[1]}
+	if got := configs.ForPath("a/b.proto"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ForPath got %v, but want %v", got, want)
+	}
+}
+
+func writeTestFile(path, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(content), 0o644)
+}
+
+func TestConfigs_PackagesAndElements(t *testing.T) {
+	fd := parseTestProto(t, `syntax = "proto3";
+
+package acme.internal.v1;
+
+message Book {
+  string name = 1;
+  string legacy_id = 2;
+}
+
+message Shelf {
+  string name = 1;
+}
+`)
+
+	rules := NewRuleRegistry()
+	err := rules.Register(158, &FieldRule{
+		Name: NewRuleName(158, "field-rule"),
+		LintField: func(f *desc.FieldDescriptor) []Problem {
+			return []Problem{{Message: "bad", Descriptor: f}}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all := []string{"acme.internal.v1.Book.name", "acme.internal.v1.Book.legacy_id", "acme.internal.v1.Shelf.name"}
+	tests := []struct {
+		name    string
+		configs Configs
+		want    []string
+	}{
+		{"None", nil, all},
+		{
+			"IncludedPackage",
+			Configs{{IncludedPackages: []string{"acme.internal.**"}, DisabledRules: []string{"core::0158"}}},
+			nil,
+		},
+		{
+			"OtherPackage",
+			Configs{{IncludedPackages: []string{"acme.public.**"}, DisabledRules: []string{"core::0158"}}},
+			all,
+		},
+		{
+			"ExcludedPackage",
+			Configs{{ExcludedPackages: []string{"acme.*.v1"}, DisabledRules: []string{"core::0158"}}},
+			all,
+		},
+		{
+			"PackageAndPath",
+			Configs{{IncludedPaths: []string{"other/**"}, IncludedPackages: []string{"acme.**"}, DisabledRules: []string{"core::0158"}}},
+			all,
+		},
+		{
+			"IncludedElement",
+			Configs{{IncludedElements: []string{"acme.internal.v1.Book"}, DisabledRules: []string{"core::0158"}}},
+			[]string{"acme.internal.v1.Shelf.name"},
+		},
+		{
+			"ExcludedElement",
+			Configs{{ExcludedElements: []string{"**.legacy_id"}, DisabledRules: []string{"core::0158"}}},
+			[]string{"acme.internal.v1.Book.legacy_id"},
+		},
+		{
+			"EnabledForElement",
+			Configs{
+				{DisabledRules: []string{"all"}},
+				{IncludedElements: []string{"**.name"}, EnabledRules: []string{"field-rule"}},
+			},
+			[]string{"acme.internal.v1.Book.name", "acme.internal.v1.Shelf.name"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := New(rules, test.configs).lintFileDescriptor(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, p := range resp.Problems {
+				got = append(got, p.Descriptor.GetFullyQualifiedName())
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Problems mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigs_IsRuleEnabledIgnoresPackagesAndElements(t *testing.T) {
+	configs := Configs{
+		{IncludedPackages: []string{"**"}, DisabledRules: []string{"a::b"}},
+		{IncludedElements: []string{"**"}, DisabledRules: []string{"a::b"}},
+	}
+	if !configs.IsRuleEnabled("a::b", "a.proto") {
+		t.Errorf("IsRuleEnabled(%q) got false, but want true", "a::b")
+	}
+}
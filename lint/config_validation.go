@@ -0,0 +1,202 @@
+package lint
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// ConfigSchema is the JSON Schema describing the config file format.
+//
+//go:embed config.schema.json
+var ConfigSchema []byte
+
+// ConfigError describes an invalid value in one of the Configs.
+type ConfigError struct {
+	// Index is the position of the offending Config in the Configs.
+	Index int
+	// Field is the name of the offending field, as written in a config file.
+	Field string
+	// Value is the offending value.
+	Value string
+	// Message describes what is wrong with the value.
+	Message string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("config[%d].%s: %q %s", e.Index, e.Field, e.Value, e.Message)
+}
+
+// Validate checks the configs against the given registry, and returns an
+// error joining a *ConfigError for every rule name or prefix that does not
+// match any registered rule, every invalid path or name pattern, and
+// every invalid custom rule.
+func (configs Configs) Validate(rules RuleRegistry) error {
+	var errs []error
+	for i, c := range configs {
+		for _, p := range c.IncludedPaths {
+			errs = append(errs, validatePathPattern(i, "included_paths", p))
+		}
+		for _, p := range c.ExcludedPaths {
+			errs = append(errs, validatePathPattern(i, "excluded_paths", p))
+		}
+		for _, p := range c.IncludedPackages {
+			errs = append(errs, validateNamePattern(i, "included_packages", p))
+		}
+		for _, p := range c.ExcludedPackages {
+			errs = append(errs, validateNamePattern(i, "excluded_packages", p))
+		}
+		for _, p := range c.IncludedElements {
+			errs = append(errs, validateNamePattern(i, "included_elements", p))
+		}
+		for _, p := range c.ExcludedElements {
+			errs = append(errs, validateNamePattern(i, "excluded_elements", p))
+		}
+		for _, r := range c.EnabledRules {
+			errs = append(errs, validateRulePrefix(i, "enabled_rules", r, rules))
+		}
+		for _, r := range c.DisabledRules {
+			errs = append(errs, validateRulePrefix(i, "disabled_rules", r, rules))
+		}
+		for j, r := range c.CustomRules {
+			errs = append(errs, validateCustomRule(i, fmt.Sprintf("custom_rules[%d]", j), r)...)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validatePathPattern(index int, field, pattern string) error {
+	if doublestar.ValidatePattern(pattern) {
+		return nil
+	}
+	return &ConfigError{Index: index, Field: field, Value: pattern, Message: "is not a valid path pattern"}
+}
+
+func validateNamePattern(index int, field, pattern string) error {
+	if doublestar.ValidatePattern(elementPath(pattern)) {
+		return nil
+	}
+	return &ConfigError{Index: index, Field: field, Value: pattern, Message: "is not a valid name pattern"}
+}
+
+// validateCustomRule checks the fields of a custom rule. Its expression and
+// message are checked when compiling it.
+func validateCustomRule(index int, field string, r CustomRule) []error {
+	var errs []error
+	if !RuleName(r.Name).IsValid() {
+		errs = append(errs, &ConfigError{Index: index, Field: field + ".name", Value: r.Name, Message: "is not a valid rule name"})
+	}
+	if !slices.Contains(CustomRuleTargets, r.Target) {
+		errs = append(errs, &ConfigError{Index: index, Field: field + ".target", Value: r.Target, Message: "is not one of " + strings.Join(CustomRuleTargets, ", ")})
+	}
+	if r.ReportIf == "" {
+		errs = append(errs, &ConfigError{Index: index, Field: field + ".report_if", Value: r.ReportIf, Message: "is required"})
+	}
+	if r.Message == "" {
+		errs = append(errs, &ConfigError{Index: index, Field: field + ".message", Value: r.Message, Message: "is required"})
+	}
+	switch r.Severity {
+	case "", "must", "should", "may":
+	default:
+		errs = append(errs, &ConfigError{Index: index, Field: field + ".severity", Value: r.Severity, Message: "is not one of must, should, may"})
+	}
+	return errs
+}
+
+func validateRulePrefix(index int, field, prefix string, rules RuleRegistry) error {
+	if msg := unknownRuleMessage(prefix, rules); msg != "" {
+		return &ConfigError{Index: index, Field: field, Value: prefix, Message: msg}
+	}
+	return nil
+}
+
+// unknownRuleMessage describes why the rule name or prefix does not match
+// any of the rules, suggesting the closest one, or returns an empty string
+// if it matches.
+func unknownRuleMessage(prefix string, rules RuleRegistry) string {
+	if matchesAnyRule(prefix, rules) {
+		return ""
+	}
+	msg := "does not match any rule"
+	if s := suggestRulePrefix(prefix, rules); s != "" {
+		msg += fmt.Sprintf("; did you mean %q?", s)
+	}
+	return msg
+}
+
+// matchesAnyRule returns true if the rule name or prefix matches any of the
+// rules, their aliases, or the rule IDs of the problems the linter reports
+// itself.
+func matchesAnyRule(prefix string, rules RuleRegistry) bool {
+	if strings.EqualFold(prefix, "all") {
+		return true
+	}
+	for _, name := range reservedRuleNames {
+		if matchRule(string(name), prefix) {
+			return true
+		}
+	}
+	for name := range rules {
+		if matchRule(string(name), prefix) || matchRule(aliasMap[string(name)], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// suggestRulePrefix returns the rule name or name segment closest to the
+// given prefix, or an empty string if none of them is close enough.
+func suggestRulePrefix(prefix string, rules RuleRegistry) string {
+	prefix = strings.ToLower(prefix)
+
+	// Collect the full rule names, and every "::" separated part of them.
+	candidates := map[string]bool{}
+	names := slices.Collect(maps.Keys(rules))
+	for _, name := range append(names, reservedRuleNames...) {
+		parts := strings.Split(string(name), nameSeparator)
+		for i := range parts {
+			candidates[strings.Join(parts[:i+1], nameSeparator)] = true
+			candidates[strings.Join(parts[i:], nameSeparator)] = true
+		}
+	}
+	sorted := make([]string, 0, len(candidates))
+	for c := range candidates {
+		sorted = append(sorted, c)
+	}
+	sort.Strings(sorted)
+
+	best, bestDistance := "", len(prefix)/3+1
+	for _, c := range sorted {
+		if d := editDistance(prefix, c); d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,140 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestConfigs_Validate(t *testing.T) {
+	registry := NewRuleRegistry()
+	if err := registry.Register(131, &FileRule{Name: NewRuleName(131, "http-body")}, &FileRule{Name: NewRuleName(131, "http-method")}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		configs Configs
+		want    []string
+	}{
+		{
+			"Valid",
+			Configs{
+				{
+					IncludedPaths: []string{"a/**/*.proto"},
+					EnabledRules:  []string{"all", "core", "core::0131", "http-body"},
+					DisabledRules: []string{"core::0131::http-method", "api-linter::unused-disable", "api-linter::parse-error", "api-linter"},
+				},
+			},
+			nil,
+		},
+		{
+			"UnknownRule",
+			Configs{{DisabledRules: []string{"core::0131::htp-body"}}},
+			[]string{`config[0].disabled_rules: "core::0131::htp-body" does not match any rule; did you mean "core::0131::http-body"?`},
+		},
+		{
+			"UnknownReservedRule",
+			Configs{{DisabledRules: []string{"api-linter::unused-disables"}}},
+			[]string{`config[0].disabled_rules: "api-linter::unused-disables" does not match any rule; did you mean "api-linter::unused-disable"?`},
+		},
+		{
+			"UnknownRuleWithoutSuggestion",
+			Configs{{}, {EnabledRules: []string{"something-else"}}},
+			[]string{`config[1].enabled_rules: "something-else" does not match any rule`},
+		},
+		{
+			"InvalidPattern",
+			Configs{{ExcludedPaths: []string{"a/[b.proto"}}},
+			[]string{`config[0].excluded_paths: "a/[b.proto" is not a valid path pattern`},
+		},
+		{
+			"InvalidCustomRule",
+			Configs{{CustomRules: []CustomRule{{Name: "Acme", Target: "oneof", ReportIf: "true", Message: "Bad.", Severity: "error"}}}},
+			[]string{
+				`config[0].custom_rules[0].name: "Acme" is not a valid rule name`,
+				`config[0].custom_rules[0].target: "oneof" is not one of file, service, method, message, field, enum`,
+				`config[0].custom_rules[0].severity: "error" is not one of must, should, may`,
+			},
+		},
+		{
+			"InvalidNamePattern",
+			Configs{{IncludedPackages: []string{"acme.[internal"}}},
+			[]string{`config[0].included_packages: "acme.[internal" is not a valid name pattern`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.configs.Validate(registry)
+			var got []string
+			if err != nil {
+				got = strings.Split(err.Error(), "\n")
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Validate() got %q, but want %q", got, test.want)
+			}
+			var configErr *ConfigError
+			if err != nil && !errors.As(err, &configErr) {
+				t.Errorf("Validate() got %T, but want a *ConfigError", err)
+			}
+		})
+	}
+}
+
+func TestReadConfigsUnknownKeys(t *testing.T) {
+	if _, err := ReadConfigsJSON(strings.NewReader(`[{"disabled_rule": ["a"]}]`)); err == nil {
+		t.Error("ReadConfigsJSON expects an error for an unknown key")
+	}
+	if _, err := ReadConfigsYAML(strings.NewReader("- disabled_rule: ['a']\n")); err == nil {
+		t.Error("ReadConfigsYAML expects an error for an unknown key")
+	}
+}
+
+func TestConfigSchema_MatchesConfig(t *testing.T) {
+	var schema struct {
+		Items struct {
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(ConfigSchema, &schema); err != nil {
+		t.Fatalf("ConfigSchema is not valid JSON: %v", err)
+	}
+	var got []string
+	for k := range schema.Items.Properties {
+		got = append(got, k)
+	}
+	var want []string
+	typ := reflect.TypeOf(Config{})
+	for i := 0; i < typ.NumField(); i++ {
+		if !typ.Field(i).IsExported() {
+			continue
+		}
+		want = append(want, strings.Split(typ.Field(i).Tag.Get("json"), ",")[0])
+	}
+	sort.Strings(got)
+	sort.Strings(want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConfigSchema properties %v do not match the Config fields %v", got, want)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"http-body", "http-body", 0},
+		{"htp-body", "http-body", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, test := range tests {
+		if got := editDistance(test.a, test.b); got != test.want {
+			t.Errorf("editDistance(%q, %q) got %d, but want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package lint
+
+// CustomRule is a rule declared in a config. It reports a problem on every
+// element of its target kind for which its CEL expression is true.
+//
+// Custom rules are compiled and registered by rules.AddCustomRules, and are
+// then enabled or disabled like any other rule, regardless of the paths,
+// packages or elements of the config declaring them.
+type CustomRule struct {
+	// Name is the name of the rule, such as "acme::0001::no-data-fields".
+	Name string `json:"name" yaml:"name"`
+	// Target is the kind of element the rule checks: "file", "service",
+	// "method", "message", "field" or "enum".
+	Target string `json:"target" yaml:"target"`
+	// ReportIf is a CEL expression over a view of the element, such as
+	// `name == "data"`, which is true if the element has a problem.
+	ReportIf string `json:"report_if" yaml:"report_if"`
+	// Message is a text/template of the problem message, executed with the
+	// view of the element, such as "Field {{.full_name}} is too vague.".
+	Message string `json:"message" yaml:"message"`
+	// Severity is "must", "should" or "may", or empty if not categorized.
+	Severity string `json:"severity,omitempty" yaml:"severity,omitempty"`
+	// Description is a short description of what the rule checks.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// CustomRuleTargets are the kinds of element a custom rule can target.
+var CustomRuleTargets = []string{"file", "service", "method", "message", "field", "enum"}
+
+// CustomRules returns the custom rules declared in the configs.
+func (configs Configs) CustomRules() []CustomRule {
+	var rules []CustomRule
+	for _, c := range configs {
+		rules = append(rules, c.CustomRules...)
+	}
+	return rules
+}
@@ -0,0 +1,299 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jhump/protoreflect/desc"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// disableDirective disables, or re-enables, a rule in a comment.
+//
+// Directives follow this grammar, where a comment line holds at most one
+// directive but a directive may hold several clauses:
+//
+//	directive := "api-linter:" clause { clause }
+//	clause    := rules "=" ( "disabled" | "enabled" ) { attribute }
+//	           | "disable-next-line" rules { attribute }
+//	rules     := rule { "," rule }
+//	attribute := ( "reason" | "until" ) "=" ( word | quoted )
+//
+// A directive in the leading or trailing comments of an element applies to
+// the element and the elements within it. A disable-next-line directive
+// only applies to problems starting on the line after the comment, and an
+// enabled directive re-enables a rule disabled by a comment on a
+// containing element, or on the file.
+type disableDirective struct {
+	// The descriptor whose comments contain the directive, or the file for
+	// the file header. Unset if location is set.
+	descriptor desc.Descriptor
+	// The source code location whose comments contain the directive, if it
+	// was not found on a descriptor.
+	location *dpb.SourceCodeInfo_Location
+	// Whether the directive is in the trailing comments, rather than the
+	// leading ones.
+	trailing bool
+	// The zero-based index of the comment line containing the directive.
+	line int
+	// The comment line containing the directive.
+	text string
+	// The rule name or prefix that is disabled.
+	rule string
+	// Whether the directive re-enables the rule instead.
+	enable bool
+	// Whether the directive only applies to the line after the comment.
+	nextLine bool
+	// The justification given with reason="...", if any.
+	reason string
+	// The date given with until=YYYY-MM-DD, after which the directive no
+	// longer disables the rule, if any.
+	until string
+}
+
+// directiveKey identifies a directive by the comments it is in, so that a
+// directive is the same whether it is found in the comments of a descriptor
+// or in those of the source code location of a problem.
+type directiveKey struct {
+	// The start of the span of the element the comments are attached to, or
+	// -1 if unknown.
+	line, column int32
+	trailing     bool
+	index        int
+	rule         string
+}
+
+// key returns the key identifying the directive.
+func (d disableDirective) key() directiveKey {
+	loc := d.location
+	if loc == nil {
+		loc = d.descriptor.GetSourceInfo()
+		if f, ok := d.descriptor.(*desc.FileDescriptor); ok {
+			loc = fileHeaderLocation(f)
+		}
+	}
+	k := directiveKey{line: -1, column: -1, trailing: d.trailing, index: d.line, rule: d.rule}
+	if span := loc.GetSpan(); len(span) >= 3 {
+		k.line, k.column = span[0], span[1]
+	}
+	return k
+}
+
+// directivePrefix starts a directive in a comment line.
+const directivePrefix = "api-linter:"
+
+// nextLineKeyword starts a clause disabling rules on the next line.
+const nextLineKeyword = "disable-next-line"
+
+// untilLayout is the layout of the until attribute of a directive.
+const untilLayout = "2006-01-02"
+
+// now returns the current time, and can be replaced in tests.
+var now = time.Now
+
+// expired returns true if the directive has an expiry date which has passed.
+func (d disableDirective) expired() bool {
+	until, err := time.Parse(untilLayout, d.until)
+	if err != nil {
+		return false
+	}
+	// The directive is valid through the end of its expiry date.
+	return !now().Before(until.AddDate(0, 0, 1))
+}
+
+// inEffect returns true if the directive disables its rules: that is, unless
+// it has expired, or has an invalid expiry date.
+func (d disableDirective) inEffect() bool {
+	return (d.until == "" || validUntil(d.until)) && !d.expired()
+}
+
+// descriptorDirectives returns the directives in the leading and trailing
+// comments of the descriptor, or in the file header for a file.
+func descriptorDirectives(d desc.Descriptor) []disableDirective {
+	var directives []disableDirective
+	if f, ok := d.(*desc.FileDescriptor); ok {
+		directives = parseDirectives(fileHeader(f))
+	} else {
+		directives = parseDirectives(getLeadingComments(d))
+		for _, directive := range parseDirectives(d.GetSourceInfo().GetTrailingComments()) {
+			directive.trailing = true
+			directives = append(directives, directive)
+		}
+	}
+	for i := range directives {
+		directives[i].descriptor = d
+	}
+	return directives
+}
+
+// locationDirectives returns the directives in the leading and trailing
+// comments of the source code location, if any.
+func locationDirectives(l *dpb.SourceCodeInfo_Location) []disableDirective {
+	if l == nil {
+		return nil
+	}
+	directives := parseDirectives(l.GetLeadingComments())
+	for _, directive := range parseDirectives(l.GetTrailingComments()) {
+		directive.trailing = true
+		directives = append(directives, directive)
+	}
+	for i := range directives {
+		directives[i].location = l
+	}
+	return directives
+}
+
+// parseDirectives returns a directive for every rule in every clause of the
+// directives in the comments.
+func parseDirectives(comments string) []disableDirective {
+	var directives []disableDirective
+	for i, commentLine := range strings.Split(comments, "\n") {
+		_, clauses, _ := parseDirectiveLine(commentLine)
+		for _, c := range clauses {
+			for _, rule := range c.rules {
+				directives = append(directives, disableDirective{
+					line:     i,
+					text:     strings.TrimSpace(commentLine),
+					rule:     rule,
+					enable:   c.enable,
+					nextLine: c.nextLine,
+					reason:   c.reason,
+					until:    c.until,
+				})
+			}
+		}
+	}
+	return directives
+}
+
+// directiveClause is one clause of a directive.
+type directiveClause struct {
+	rules    []string
+	enable   bool
+	nextLine bool
+	reason   string
+	until    string
+}
+
+// String returns the clause as it would be written in a directive.
+func (c directiveClause) String() string {
+	var b strings.Builder
+	rules := strings.Join(c.rules, ",")
+	switch {
+	case c.nextLine:
+		b.WriteString(nextLineKeyword + " " + rules)
+	case c.enable:
+		b.WriteString(rules + "=enabled")
+	default:
+		b.WriteString(rules + "=disabled")
+	}
+	if c.reason != "" {
+		fmt.Fprintf(&b, " reason=%q", c.reason)
+	}
+	if c.until != "" {
+		b.WriteString(" until=" + c.until)
+	}
+	return b.String()
+}
+
+// parseDirectiveLine parses the directive in a comment line, if any. It
+// returns the offsets of the start of the directive and of the end of its
+// last clause, which are both -1 if there is no directive.
+//
+// Parsing stops at the first word which does not continue a clause, such as
+// the "--)" closing an internal comment.
+func parseDirectiveLine(commentLine string) (start int, clauses []directiveClause, end int) {
+	start = strings.Index(commentLine, directivePrefix)
+	if start < 0 {
+		return -1, nil, -1
+	}
+	end = -1
+	words := directiveWords(commentLine, start+len(directivePrefix))
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		switch name, value, _ := strings.Cut(w.text, "="); {
+		case w.text == nextLineKeyword && i+1 < len(words) && !strings.Contains(words[i+1].text, "="):
+			i++
+			clauses = append(clauses, directiveClause{rules: splitRules(words[i].text), nextLine: true})
+		case (name == "reason" || name == "until") && len(clauses) > 0:
+			value = strings.TrimSuffix(strings.TrimPrefix(value, `"`), `"`)
+			if name == "reason" {
+				clauses[len(clauses)-1].reason = value
+			} else {
+				clauses[len(clauses)-1].until = value
+			}
+		case name != "" && (value == "disabled" || value == "enabled"):
+			clauses = append(clauses, directiveClause{rules: splitRules(name), enable: value == "enabled"})
+		default:
+			return start, clauses, end
+		}
+		end = words[i].end
+	}
+	return start, clauses, end
+}
+
+func splitRules(rules string) []string {
+	var split []string
+	for _, r := range strings.Split(rules, ",") {
+		if r != "" {
+			split = append(split, r)
+		}
+	}
+	return split
+}
+
+// directiveWord is a whitespace separated word of a directive, with the
+// whitespace around "=" and "," removed.
+type directiveWord struct {
+	text string
+	// The offset of the end of the word in the comment line.
+	end int
+}
+
+func directiveWords(s string, i int) []directiveWord {
+	var words []directiveWord
+	for {
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+		if i >= len(s) {
+			return words
+		}
+		var b strings.Builder
+		for i < len(s) {
+			c := s[i]
+			switch {
+			case c == '"':
+				closing := strings.IndexByte(s[i+1:], '"')
+				if closing < 0 {
+					closing = len(s) - i - 2
+				}
+				b.WriteString(s[i : i+closing+2])
+				i += closing + 2
+				continue
+			case isSpace(c):
+				j := i
+				for j < len(s) && isSpace(s[j]) {
+					j++
+				}
+				text := b.String()
+				if strings.HasSuffix(text, "=") || strings.HasSuffix(text, ",") ||
+					(j < len(s) && (s[j] == '=' || s[j] == ',')) {
+					i = j
+					continue
+				}
+			}
+			if isSpace(c) {
+				break
+			}
+			b.WriteByte(c)
+			i++
+		}
+		words = append(words, directiveWord{text: b.String(), end: i})
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t'
+}
@@ -0,0 +1,82 @@
+package lint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+const (
+	// ExpiredDisableRuleName is the rule ID of problems reporting disable
+	// comments whose expiry date has passed. Expired disable comments no
+	// longer disable anything.
+	ExpiredDisableRuleName RuleName = "api-linter::expired-disable"
+
+	// InvalidDisableRuleName is the rule ID of problems reporting disable
+	// comments with an invalid expiry date, or without a reason or expiry
+	// date required by the configs.
+	InvalidDisableRuleName RuleName = "api-linter::invalid-disable"
+)
+
+// disablePolicyProblems returns a problem for every disable directive in the
+// file that has expired, or that does not follow the requirements of the
+// configs for the file.
+func (l *Linter) disablePolicyProblems(fd *desc.FileDescriptor) []Problem {
+	var problems []Problem
+	for _, d := range allDescriptors(fd) {
+		requireReason, requireExpiry := l.configs.disableRequirements(d)
+		for _, directive := range descriptorDirectives(d) {
+			if directive.enable {
+				continue
+			}
+			var ruleID RuleName
+			var msg string
+			switch {
+			case directive.expired():
+				ruleID = ExpiredDisableRuleName
+				msg = fmt.Sprintf("Disable comment for %q expired on %s, and no longer disables the rule.", directive.rule, directive.until)
+			case directive.until != "" && !validUntil(directive.until):
+				ruleID = InvalidDisableRuleName
+				msg = fmt.Sprintf("Disable comment for %q has an invalid expiry date %q; use the YYYY-MM-DD format.", directive.rule, directive.until)
+			case requireReason && directive.reason == "":
+				ruleID = InvalidDisableRuleName
+				msg = fmt.Sprintf(`Disable comment for %q must give a reason, as in reason="...".`, directive.rule)
+			case requireExpiry && directive.until == "":
+				ruleID = InvalidDisableRuleName
+				msg = fmt.Sprintf("Disable comment for %q must give an expiry date, as in until=YYYY-MM-DD.", directive.rule)
+			default:
+				continue
+			}
+			p := Problem{
+				Message:    msg,
+				Descriptor: d,
+				RuleID:     ruleID,
+			}
+			if c, ok := findDirectiveComment(directive); ok {
+				p.Location = c.location()
+			}
+			problems = append(problems, p)
+		}
+	}
+	return problems
+}
+
+func validUntil(until string) bool {
+	_, err := time.Parse(untilLayout, until)
+	return err == nil
+}
+
+// disableRequirements returns whether the configs that apply to the given
+// descriptor require disable comments to give a reason, and an expiry date.
+func (configs Configs) disableRequirements(d desc.Descriptor) (reason, expiry bool) {
+	t := descriptorTarget(d)
+	for _, c := range configs {
+		if c.matchTarget(t) != fullMatch {
+			continue
+		}
+		reason = reason || c.RequireDisableReason
+		expiry = expiry || c.RequireDisableExpiry
+	}
+	return reason, expiry
+}
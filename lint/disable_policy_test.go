@@ -0,0 +1,143 @@
+package lint
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestParseDirectives(t *testing.T) {
+	comments := `Some comment.
+(-- api-linter: core::0131::http-body=disabled --)
+(-- api-linter: core::0131::http-method=disabled reason="Legacy API, see b/123." until=2027-01-01 --)
+(-- api-linter: core::0132=disabled until=soon --)
+api-linter: core::0133 , core::0134 = disabled core::0135=enabled
+api-linter: disable-next-line core::0136 reason=Generated.`
+	got := parseDirectives(comments)
+	want := []disableDirective{
+		{line: 1, text: "(-- api-linter: core::0131::http-body=disabled --)", rule: "core::0131::http-body"},
+		{
+			line:   2,
+			text:   `(-- api-linter: core::0131::http-method=disabled reason="Legacy API, see b/123." until=2027-01-01 --)`,
+			rule:   "core::0131::http-method",
+			reason: "Legacy API, see b/123.",
+			until:  "2027-01-01",
+		},
+		{line: 3, text: "(-- api-linter: core::0132=disabled until=soon --)", rule: "core::0132", until: "soon"},
+		{line: 4, text: "api-linter: core::0133 , core::0134 = disabled core::0135=enabled", rule: "core::0133"},
+		{line: 4, text: "api-linter: core::0133 , core::0134 = disabled core::0135=enabled", rule: "core::0134"},
+		{line: 4, text: "api-linter: core::0133 , core::0134 = disabled core::0135=enabled", rule: "core::0135", enable: true},
+		{line: 5, text: "api-linter: disable-next-line core::0136 reason=Generated.", rule: "core::0136", nextLine: true, reason: "Generated."},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(disableDirective{})); diff != "" {
+		t.Errorf("parseDirectives mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLinter_DisablePolicy(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	fd := parseTestProto(t, `syntax = "proto3";
+
+package test;
+
+// (-- api-linter: core::0111::message-name=disabled until=2026-05-31 --)
+message Expired {}
+
+// (-- api-linter: core::0111::message-name=disabled until=2026-06-01 --)
+message NotExpired {}
+
+// (-- api-linter: core::0111::message-name=disabled reason="Legacy." until=2026-06-01 --)
+message Justified {}
+
+// (-- api-linter: core::0111::message-name=disabled until=June --)
+message Invalid {}
+
+// (-- api-linter: core::0111::message-name=disabled until=2024-13-45 --)
+message InvalidDate {}
+`)
+
+	rules := NewRuleRegistry()
+	err := rules.Register(111, &MessageRule{
+		Name: NewRuleName(111, "message-name"),
+		LintMessage: func(m *desc.MessageDescriptor) []Problem {
+			return []Problem{{Message: "bad", Descriptor: m}}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		configs Configs
+		want    map[string][]RuleName
+	}{
+		{
+			name: "NoRequirements",
+			want: map[string][]RuleName{
+				"test.Expired":     {ExpiredDisableRuleName, NewRuleName(111, "message-name")},
+				"test.Invalid":     {InvalidDisableRuleName, NewRuleName(111, "message-name")},
+				"test.InvalidDate": {InvalidDisableRuleName, NewRuleName(111, "message-name")},
+			},
+		},
+		{
+			name:    "RequireReason",
+			configs: Configs{{RequireDisableReason: true}},
+			want: map[string][]RuleName{
+				"test.Expired":     {ExpiredDisableRuleName, NewRuleName(111, "message-name")},
+				"test.NotExpired":  {InvalidDisableRuleName},
+				"test.Invalid":     {InvalidDisableRuleName, NewRuleName(111, "message-name")},
+				"test.InvalidDate": {InvalidDisableRuleName, NewRuleName(111, "message-name")},
+			},
+		},
+		{
+			name:    "DisabledByConfig",
+			configs: Configs{{DisabledRules: []string{string(ExpiredDisableRuleName)}}},
+			want: map[string][]RuleName{
+				"test.Expired":     {NewRuleName(111, "message-name")},
+				"test.Invalid":     {InvalidDisableRuleName, NewRuleName(111, "message-name")},
+				"test.InvalidDate": {InvalidDisableRuleName, NewRuleName(111, "message-name")},
+			},
+		},
+		{
+			name:    "RequirementsForOtherPaths",
+			configs: Configs{{IncludedPaths: []string{"other.proto"}, RequireDisableReason: true}},
+			want: map[string][]RuleName{
+				"test.Expired":     {ExpiredDisableRuleName, NewRuleName(111, "message-name")},
+				"test.Invalid":     {InvalidDisableRuleName, NewRuleName(111, "message-name")},
+				"test.InvalidDate": {InvalidDisableRuleName, NewRuleName(111, "message-name")},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := New(rules, test.configs).lintFileDescriptor(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := map[string][]RuleName{}
+			for _, p := range resp.Problems {
+				name := descriptorName(p.Descriptor)
+				got[name] = append(got[name], p.RuleID)
+			}
+			for _, ids := range got {
+				sortRuleNames(ids)
+			}
+			for _, ids := range test.want {
+				sortRuleNames(ids)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Problems mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func sortRuleNames(names []RuleName) {
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+}
@@ -18,8 +18,8 @@ package lint
 
 import (
 	"errors"
-	"fmt"
 	"runtime/debug"
+	"slices"
 	"strings"
 
 	"github.com/jhump/protoreflect/desc"
@@ -31,6 +31,9 @@ type Linter struct {
 	configs               Configs
 	debug                 bool
 	ignoreCommentDisables bool
+	reportUnusedDisables  bool
+	suppressions          Suppressions
+	ruleDocURLTemplate    string
 }
 
 // LinterOption prvoides the ability to configure the Linter.
@@ -50,6 +53,31 @@ func IgnoreCommentDisables(ignoreCommentDisables bool) LinterOption {
 	}
 }
 
+// ReportUnusedDisables sets the flag for reporting disable comments which
+// did not suppress any problem, or which refer to rules that do not exist.
+func ReportUnusedDisables(reportUnusedDisables bool) LinterOption {
+	return func(l *Linter) {
+		l.reportUnusedDisables = reportUnusedDisables
+	}
+}
+
+// UseSuppressions sets the suppressions which disable rules on descriptors
+// by their fully qualified names.
+func UseSuppressions(suppressions Suppressions) LinterOption {
+	return func(l *Linter) {
+		l.suppressions = suppressions
+	}
+}
+
+// RuleDocURLTemplate sets the template of the rule documentation URLs of
+// the problems, as described in RuleURLFromTemplate. It takes precedence
+// over the rule_doc_uri of the configs.
+func RuleDocURLTemplate(template string) LinterOption {
+	return func(l *Linter) {
+		l.ruleDocURLTemplate = template
+	}
+}
+
 // New creates and returns a linter with the given rules and configs.
 func New(rules RuleRegistry, configs Configs, opts ...LinterOption) *Linter {
 	l := &Linter{
@@ -64,17 +92,22 @@ func New(rules RuleRegistry, configs Configs, opts ...LinterOption) *Linter {
 	return l
 }
 
-// LintProtos checks protobuf files and returns a list of problems or an error.
+// LintProtos checks protobuf files and returns a list of problems.
+//
+// If rules fail, the error joins a *RuleError for each failure, and the
+// responses still hold the problems of the other rules.
 func (l *Linter) LintProtos(files ...*desc.FileDescriptor) ([]Response, error) {
 	var responses []Response
+	var errs []error
 	for _, proto := range files {
 		resp, err := l.lintFileDescriptor(proto)
 		if err != nil {
-			return nil, err
+			errs = append(errs, err)
 		}
 		responses = append(responses, resp)
 	}
-	return responses, nil
+	SortResponses(responses)
+	return responses, errors.Join(errs...)
 }
 
 // run executes rules on the request.
@@ -87,50 +120,107 @@ func (l *Linter) lintFileDescriptor(fd *desc.FileDescriptor) (Response, error) {
 		FilePath: fd.GetName(),
 		Problems: []Problem{},
 	}
-	var errMessages []string
+	var errs []*RuleError
+	suppressing := map[directiveKey]bool{}
+	checkElements := l.configs.hasElementCriteria()
 
 	for name, rule := range l.rules {
 		// Run the linter rule against this file, and throw away any problems
 		// which should have been disabled.
-		if l.configs.IsRuleEnabled(string(name), fd.GetName()) {
+		if l.configs.traceIsRuleEnabled(string(name), ruleTarget{path: fd.GetName(), file: fd}, nil) {
 			if problems, err := l.runAndRecoverFromPanics(rule, fd); err == nil {
 				for _, p := range problems {
 					if p.Descriptor == nil {
-						errMessages = append(errMessages, fmt.Sprintf("rule %q missing required Descriptor in returned Problem", rule.GetName()))
+						errs = append(errs, &RuleError{
+							Rule:    rule.GetName(),
+							File:    fd.GetName(),
+							Message: "missing required Descriptor in returned Problem",
+						})
+						continue
+					}
+					if checkElements && !l.configs.IsRuleEnabledForDescriptor(string(name), p.Descriptor) {
+						continue
+					}
+					enabled, directive := traceRuleIsEnabled(rule, p.Descriptor, p.Location, aliasMap, l.ignoreCommentDisables, nil)
+					if enabled && l.suppressions.suppressing(rule, p.Descriptor) >= 0 {
 						continue
 					}
-					if ruleIsEnabled(rule, p.Descriptor, p.Location, aliasMap, l.ignoreCommentDisables) {
+					if enabled {
 						p.RuleID = rule.GetName()
+						p.ruleDocURI = l.ruleDocURL(rule, p.Descriptor)
 						resp.Problems = append(resp.Problems, p)
+					} else if directive != nil {
+						suppressing[directive.key()] = true
 					}
 				}
 			} else {
-				errMessages = append(errMessages, err.Error())
+				errs = append(errs, err)
 			}
 		}
 	}
 
-	var err error
-	if len(errMessages) != 0 {
-		err = errors.New(strings.Join(errMessages, "; "))
+	if !l.ignoreCommentDisables {
+		resp.Problems = append(resp.Problems, l.configs.enabledProblems(l.disablePolicyProblems(fd))...)
+		if l.reportUnusedDisables {
+			resp.Problems = append(resp.Problems, l.configs.enabledProblems(l.unusedDisables(fd, suppressing))...)
+		}
+	}
+
+	resp.Problems = sortProblems(resp.Problems)
+
+	// The rules run in no particular order, so sort their errors to report
+	// them consistently.
+	slices.SortStableFunc(errs, func(a, b *RuleError) int {
+		return strings.Compare(string(a.Rule), string(b.Rule))
+	})
+	joined := make([]error, len(errs))
+	for i, err := range errs {
+		joined[i] = err
 	}
+	return resp, errors.Join(joined...)
+}
 
-	return resp, err
+// ruleDocURL returns the URL of the documentation of the rule for a problem
+// on the given descriptor, or an empty string if the problem should use the
+// rule URL mappings.
+func (l *Linter) ruleDocURL(rule ProtoRule, d desc.Descriptor) string {
+	template := l.ruleDocURLTemplate
+	if template == "" {
+		template = l.configs.ruleDocURLTemplate(d)
+	}
+	if described, ok := rule.(DescribedRule); template == "" && (!ok || described.GetMetadata().DocURL == "") {
+		return ""
+	}
+	return RuleDocURL(rule, template)
 }
 
-func (l *Linter) runAndRecoverFromPanics(rule ProtoRule, fd *desc.FileDescriptor) (probs []Problem, err error) {
+// runAndRecoverFromPanics runs the rule on the file, and returns a
+// *RuleError if it panics or, for a FallibleRule, if it fails.
+func (l *Linter) runAndRecoverFromPanics(rule ProtoRule, fd *desc.FileDescriptor) (probs []Problem, err *RuleError) {
 	defer func() {
 		if r := recover(); r != nil {
 			if l.debug {
 				debug.PrintStack()
 			}
-			if rerr, ok := r.(error); ok {
-				err = rerr
-			} else {
-				err = fmt.Errorf("panic occurred during rule execution: %v", r)
+			err = &RuleError{
+				Rule:  rule.GetName(),
+				File:  fd.GetName(),
+				Panic: r,
+				Stack: debug.Stack(),
 			}
 		}
 	}()
 
+	if r, ok := rule.(FallibleRule); ok {
+		problems, lintErr := r.LintWithError(fd)
+		if lintErr != nil {
+			return nil, &RuleError{
+				Rule: rule.GetName(),
+				File: fd.GetName(),
+				Err:  lintErr,
+			}
+		}
+		return problems, nil
+	}
 	return rule.Lint(fd), nil
 }
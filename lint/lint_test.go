@@ -15,11 +15,12 @@
 package lint
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
-	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 )
@@ -109,10 +110,14 @@ func TestLinter_LintProtos_RulePanics(t *testing.T) {
 	}
 
 	testAIP := 111
+	errPanic := fmt.Errorf("panic")
+	errFailure := fmt.Errorf("failure")
 
 	tests := []struct {
-		testName string
-		rule     ProtoRule
+		testName  string
+		rule      ProtoRule
+		wantPanic any
+		wantErr   error
 	}{
 		{
 			testName: "Panic",
@@ -122,13 +127,32 @@ func TestLinter_LintProtos_RulePanics(t *testing.T) {
 					panic("panic")
 				},
 			},
+			wantPanic: "panic",
 		},
 		{
 			testName: "PanicError",
 			rule: &FileRule{
 				Name: NewRuleName(testAIP, "panic-error"),
 				LintFile: func(_ *desc.FileDescriptor) []Problem {
-					panic(fmt.Errorf("panic"))
+					panic(errPanic)
+				},
+			},
+			wantPanic: errPanic,
+		},
+		{
+			testName: "Error",
+			rule: &fallibleRule{
+				FileRule: &FileRule{Name: NewRuleName(testAIP, "error")},
+				err:      errFailure,
+			},
+			wantErr: errFailure,
+		},
+		{
+			testName: "MissingDescriptor",
+			rule: &FileRule{
+				Name: NewRuleName(testAIP, "missing-descriptor"),
+				LintFile: func(_ *desc.FileDescriptor) []Problem {
+					return []Problem{{Message: "No descriptor."}}
 				},
 			},
 		},
@@ -137,7 +161,12 @@ func TestLinter_LintProtos_RulePanics(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
 			rules := NewRuleRegistry()
-			err := rules.Register(testAIP, test.rule)
+			err := rules.Register(testAIP, test.rule, &FileRule{
+				Name: NewRuleName(testAIP, "working"),
+				LintFile: func(fd *desc.FileDescriptor) []Problem {
+					return []Problem{{Message: "Working.", Descriptor: fd}}
+				},
+			})
 			if err != nil {
 				t.Fatalf("Failed to create Rules: %q", err)
 			}
@@ -145,14 +174,45 @@ func TestLinter_LintProtos_RulePanics(t *testing.T) {
 			// Instantiate a linter with the given rule.
 			l := New(rules, nil)
 
-			_, err = l.LintProtos(fd)
-			if err == nil || !strings.Contains(err.Error(), "panic") {
-				t.Fatalf("Expected error with panic, got %q", err)
+			resps, err := l.LintProtos(fd)
+			var ruleErr *RuleError
+			if !errors.As(err, &ruleErr) {
+				t.Fatalf("LintProtos got error %v, but want a *RuleError", err)
+			}
+			if ruleErr.Rule != test.rule.GetName() || ruleErr.File != "test.proto" {
+				t.Errorf("RuleError is for rule %q and file %q, but want %q and %q", ruleErr.Rule, ruleErr.File, test.rule.GetName(), "test.proto")
+			}
+			if ruleErr.Panic != test.wantPanic {
+				t.Errorf("RuleError.Panic is %v, but want %v", ruleErr.Panic, test.wantPanic)
+			}
+			if (test.wantPanic != nil) != (len(ruleErr.Stack) > 0) {
+				t.Errorf("RuleError.Stack is %q, but want a stack only for panics", ruleErr.Stack)
+			}
+			if err, ok := test.wantPanic.(error); ok && !errors.Is(ruleErr, err) {
+				t.Errorf("RuleError %v does not wrap %v", ruleErr, err)
+			}
+			if test.wantErr != nil && !errors.Is(ruleErr, test.wantErr) {
+				t.Errorf("RuleError %v does not wrap %v", ruleErr, test.wantErr)
+			}
+
+			// The problems of the other rule are still returned.
+			if len(resps) != 1 || len(resps[0].Problems) != 1 || resps[0].Problems[0].Message != "Working." {
+				t.Errorf("LintProtos got responses %v, but want the problem of the working rule", resps)
 			}
 		})
 	}
 }
 
+// fallibleRule is a FileRule which fails with the given error.
+type fallibleRule struct {
+	*FileRule
+	err error
+}
+
+func (r *fallibleRule) LintWithError(fd *desc.FileDescriptor) ([]Problem, error) {
+	return nil, r.err
+}
+
 func TestLinter_debug(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -204,3 +264,78 @@ func TestLinter_IgnoreCommentDisables(t *testing.T) {
 		})
 	}
 }
+
+func TestLinter_RuleDocURLTemplate(t *testing.T) {
+	fd, err := builder.NewFile("test.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor.")
+	}
+	lintFile := func(f *desc.FileDescriptor) []Problem {
+		return []Problem{{Message: "problem", Descriptor: f}}
+	}
+	rules := NewRuleRegistry()
+	if err := rules.RegisterRule(
+		&FileRule{Name: "core::0111::mapped", LintFile: lintFile},
+		&FileRule{Name: "core::0111::described", LintFile: lintFile, Metadata: RuleMetadata{DocURL: "https://example.com/described"}},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		configs  Configs
+		want     map[RuleName]string
+	}{
+		{
+			name: "Mappings",
+			want: map[RuleName]string{
+				"core::0111::mapped":    "https://linter.aep.dev/111/mapped",
+				"core::0111::described": "https://example.com/described",
+			},
+		},
+		{
+			name:     "Template",
+			template: "https://docs.example.com/{group}/{aep}/{name}",
+			want: map[RuleName]string{
+				"core::0111::mapped":    "https://docs.example.com/core/111/mapped",
+				"core::0111::described": "https://example.com/described",
+			},
+		},
+		{
+			name: "Config",
+			configs: Configs{
+				{RuleDocURI: "https://docs.example.com/{rule}"},
+				{IncludedPaths: []string{"other.proto"}, RuleDocURI: "https://other.example.com/{rule}"},
+			},
+			want: map[RuleName]string{
+				"core::0111::mapped":    "https://docs.example.com/core::0111::mapped",
+				"core::0111::described": "https://example.com/described",
+			},
+		},
+		{
+			name:     "TemplateOverridesConfig",
+			template: "https://docs.example.com/{group}/{aep}/{name}",
+			configs:  Configs{{RuleDocURI: "https://other.example.com/{rule}"}},
+			want: map[RuleName]string{
+				"core::0111::mapped":    "https://docs.example.com/core/111/mapped",
+				"core::0111::described": "https://example.com/described",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := New(rules, test.configs, RuleDocURLTemplate(test.template)).LintProtos(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := map[RuleName]string{}
+			for _, p := range resp[0].Problems {
+				got[p.RuleID] = p.GetRuleURI()
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("GetRuleURI() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+// Package linttest runs rules against proto files annotated with the
+// problems they are expected to report.
+//
+// An expectation is a comment on the line the problem starts on:
+//
+//	rpc GetBook(GetBookRequest) returns (Book) {  // want "core::0131::http-body"
+//
+// A comment may expect several problems, and may give the one-based column
+// each of them starts at, as the linter reports it:
+//
+//	message Book {}  // want "acme::0001::a" "acme::0002::b"@1
+//
+// The expectation comments are blanked out before the files are parsed, so
+// they are neither seen by rules checking comments nor shift the positions
+// of the problems.
+package linttest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aep-dev/api-linter/lint"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+
+	// These imports cause the common protos to be registered with
+	// the protocol buffer registry, so that test files can import them.
+	_ "buf.build/gen/go/aep/api/protocolbuffers/go/aep/api"
+	_ "cloud.google.com/go/longrunning/autogen/longrunningpb"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/type/date"
+	_ "google.golang.org/genproto/googleapis/type/datetime"
+	_ "google.golang.org/genproto/googleapis/type/timeofday"
+)
+
+// TestingT is the part of testing.TB used by the runner.
+type TestingT interface {
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+	Helper()
+}
+
+// Run lints the proto files, named relative to dir, with the rule, and
+// reports an error for every problem that is not expected, and for every
+// expectation no problem meets. The files can import each other and the
+// common AEP and Google API protos.
+//
+// The rule is enabled for the files, and the disable comments in them
+// apply. Run returns the responses of the linter.
+func Run(t TestingT, dir string, rule lint.ProtoRule, files ...string) []lint.Response {
+	t.Helper()
+	r := newRunner(t, dir)
+	responses := r.lint(rule, files)
+	r.check(responses)
+	return responses
+}
+
+// RunWithSuggestions is like Run, and also applies the suggestions of the
+// problems to each file and compares the result with the golden file named
+// like the file, with an additional ".golden" extension.
+func RunWithSuggestions(t TestingT, dir string, rule lint.ProtoRule, files ...string) []lint.Response {
+	t.Helper()
+	r := newRunner(t, dir)
+	responses := r.lint(rule, files)
+	r.check(responses)
+	for _, resp := range responses {
+		r.checkSuggestions(resp)
+	}
+	return responses
+}
+
+// runner lints the files of a directory.
+type runner struct {
+	t   TestingT
+	dir string
+	// The files read so far, by name.
+	sources map[string]*source
+}
+
+// source is a proto file with expectations.
+type source struct {
+	content      []byte
+	stripped     []byte
+	expectations []*expectation
+}
+
+// expectation is a problem expected by a comment.
+type expectation struct {
+	line   int
+	column int
+	rule   lint.RuleName
+	met    bool
+}
+
+func newRunner(t TestingT, dir string) *runner {
+	return &runner{t: t, dir: dir, sources: map[string]*source{}}
+}
+
+func (r *runner) lint(rule lint.ProtoRule, files []string) []lint.Response {
+	r.t.Helper()
+	parser := protoparse.Parser{
+		Accessor:              r.open,
+		IncludeSourceCodeInfo: true,
+		LookupImport:          desc.LoadFileDescriptor,
+	}
+	fds, err := parser.ParseFiles(files...)
+	if err != nil {
+		r.t.Fatalf("parsing %v: %v", files, err)
+	}
+
+	registry := lint.NewRuleRegistry()
+	if err := registry.RegisterRule(rule); err != nil {
+		r.t.Fatalf("registering %s: %v", rule.GetName(), err)
+	}
+	configs := lint.Configs{{EnabledRules: []string{string(rule.GetName())}}}
+	responses, err := lint.New(registry, configs).LintProtos(fds...)
+	if err != nil {
+		r.t.Fatalf("linting %v: %v", files, err)
+	}
+	return responses
+}
+
+// open reads a file of the directory, without its expectation comments.
+func (r *runner) open(name string) (io.ReadCloser, error) {
+	content, err := os.ReadFile(filepath.Join(r.dir, name))
+	if err != nil {
+		return nil, err
+	}
+	src, err := parseSource(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	r.sources[name] = src
+	return io.NopCloser(bytes.NewReader(src.stripped)), nil
+}
+
+// check matches the problems with the expectations.
+func (r *runner) check(responses []lint.Response) {
+	r.t.Helper()
+	for _, resp := range responses {
+		src := r.sources[resp.FilePath]
+		for _, p := range resp.Problems {
+			line, column := start(p)
+			if !src.meet(line, column, p.RuleID) {
+				r.t.Errorf("%s:%d:%d: unexpected problem from %s: %s", resp.FilePath, line, column, p.RuleID, p.Message)
+			}
+		}
+		for _, e := range src.expectations {
+			if !e.met {
+				r.t.Errorf("%s:%d: expected a problem from %s%s, but got none", resp.FilePath, e.line, e.rule, e.columnString())
+			}
+		}
+	}
+}
+
+// checkSuggestions compares the file with the suggestions applied with its
+// golden file.
+func (r *runner) checkSuggestions(resp lint.Response) {
+	r.t.Helper()
+	content := r.sources[resp.FilePath].content
+	type edit struct {
+		start, end int
+		text       string
+	}
+	var edits []edit
+	for _, p := range resp.Problems {
+		if p.Suggestion == "" {
+			continue
+		}
+		span := problemSpan(p)
+		if len(span) < 3 {
+			r.t.Errorf("%s: problem from %s has no span", resp.FilePath, p.RuleID)
+			continue
+		}
+		endLine, endColumn := span[0], span[2]
+		if len(span) == 4 {
+			endLine, endColumn = span[2], span[3]
+		}
+		start, end := offset(content, span[0], span[1]), offset(content, endLine, endColumn)
+		if start < 0 || end < start {
+			r.t.Errorf("%s: problem from %s has an invalid span %v", resp.FilePath, p.RuleID, span)
+			continue
+		}
+		edits = append(edits, edit{start, end, p.Suggestion})
+	}
+
+	// Apply the edits from the end, so that the offsets stay valid.
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	got := append([]byte(nil), content...)
+	limit := len(got)
+	for _, e := range edits {
+		if e.end > limit {
+			r.t.Errorf("%s: overlapping suggestions at offset %d", resp.FilePath, e.start)
+			continue
+		}
+		got = append(got[:e.start], append([]byte(e.text), got[e.end:]...)...)
+		limit = e.start
+	}
+
+	golden := filepath.Join(r.dir, resp.FilePath+".golden")
+	want, err := os.ReadFile(golden)
+	if errors.Is(err, os.ErrNotExist) && len(edits) == 0 {
+		return
+	}
+	if err != nil {
+		r.t.Errorf("%s: %v", resp.FilePath, err)
+		return
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		r.t.Errorf("%s: suggestions mismatch (-%s +got):\n%s", resp.FilePath, golden, diff)
+	}
+}
+
+// meet marks the first unmet expectation the problem meets as met, and
+// returns whether there is one.
+func (s *source) meet(line, column int, rule lint.RuleName) bool {
+	for _, e := range s.expectations {
+		if !e.met && e.line == line && e.rule == rule && (e.column == 0 || e.column == column) {
+			e.met = true
+			return true
+		}
+	}
+	return false
+}
+
+func (e *expectation) columnString() string {
+	if e.column == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" at column %d", e.column)
+}
+
+var wantComment = regexp.MustCompile(`//\s*want\s+"`)
+
+// parseSource reads the expectations of a file, and blanks out their
+// comments.
+func parseSource(content []byte) (*source, error) {
+	src := &source{content: content, stripped: append([]byte(nil), content...)}
+	lineStart := 0
+	for i, line := range bytes.Split(content, []byte("\n")) {
+		if loc := wantComment.FindIndex(line); loc != nil {
+			expectations, err := parseExpectations(string(line[loc[1]-1:]), i+1)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			src.expectations = append(src.expectations, expectations...)
+			for j := lineStart + loc[0]; j < lineStart+len(line); j++ {
+				src.stripped[j] = ' '
+			}
+		}
+		lineStart += len(line) + 1
+	}
+	return src, nil
+}
+
+// parseExpectations parses the expectations of a comment, such as
+// `"core::0131::http-body" "core::0131::http-method"@3`.
+func parseExpectations(s string, line int) ([]*expectation, error) {
+	var expectations []*expectation
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		quoted, err := strconv.QuotedPrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expectation %q", s)
+		}
+		rule, _ := strconv.Unquote(quoted)
+		e := &expectation{line: line, rule: lint.RuleName(rule)}
+		if !e.rule.IsValid() {
+			return nil, fmt.Errorf("invalid rule name %q", rule)
+		}
+		s = s[len(quoted):]
+		if rest, ok := strings.CutPrefix(s, "@"); ok {
+			digits := len(rest) - len(strings.TrimLeft(rest, "0123456789"))
+			if e.column, err = strconv.Atoi(rest[:digits]); err != nil {
+				return nil, fmt.Errorf("invalid column in %q", s)
+			}
+			s = rest[digits:]
+		}
+		expectations = append(expectations, e)
+	}
+	return expectations, nil
+}
+
+// problemSpan returns the span of a problem: its location, or else the one
+// of its descriptor.
+func problemSpan(p lint.Problem) []int32 {
+	if p.Location != nil {
+		return p.Location.GetSpan()
+	}
+	return p.Descriptor.GetSourceInfo().GetSpan()
+}
+
+// start returns the one-based line and column a problem starts at.
+func start(p lint.Problem) (line, column int) {
+	span := problemSpan(p)
+	if len(span) < 3 {
+		return 0, 0
+	}
+	return int(span[0]) + 1, int(span[1]) + 1
+}
+
+// offset returns the byte offset of a zero-based line and column of a
+// span, or -1 if it is outside of the content. As in spans, tabs advance
+// the column to the next multiple of 8.
+func offset(content []byte, line, column int32) int {
+	off := 0
+	for ; line > 0; line-- {
+		i := bytes.IndexByte(content[off:], '\n')
+		if i < 0 {
+			return -1
+		}
+		off += i + 1
+	}
+	for col := int32(0); col < column; {
+		if off >= len(content) || content[off] == '\n' {
+			return -1
+		}
+		r, size := utf8.DecodeRune(content[off:])
+		if r == '\t' {
+			col += 8 - col%8
+		} else {
+			col++
+		}
+		off += size
+	}
+	return off
+}
@@ -0,0 +1,131 @@
+package linttest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aep-dev/api-linter/lint"
+	"github.com/aep-dev/api-linter/locations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var badNames = &lint.MessageRule{
+	Name: "acme::0001::bad-names",
+	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
+		if !strings.HasPrefix(m.GetName(), "Bad") {
+			return nil
+		}
+		return []lint.Problem{{
+			Message:    "Messages must not be bad.",
+			Suggestion: "Good" + strings.TrimPrefix(m.GetName(), "Bad"),
+			Descriptor: m,
+			Location:   locations.DescriptorName(m),
+		}}
+	},
+}
+
+// recorder is a TestingT recording the errors.
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Helper() {}
+
+func TestRunWithSuggestions(t *testing.T) {
+	responses := RunWithSuggestions(t, "testdata", badNames, "library.proto")
+	if got := len(responses[0].Problems); got != 2 {
+		t.Errorf("RunWithSuggestions got %d problems, but want 2", got)
+	}
+}
+
+func TestRun_Mismatch(t *testing.T) {
+	r := &recorder{}
+	Run(r, "testdata", badNames, "mismatch.proto")
+	want := []string{
+		"mismatch.proto:5:9: unexpected problem from acme::0001::bad-names: Messages must not be bad.",
+		"mismatch.proto:9:9: unexpected problem from acme::0001::bad-names: Messages must not be bad.",
+		"mismatch.proto:7: expected a problem from acme::0001::bad-names, but got none",
+		"mismatch.proto:9: expected a problem from acme::0001::bad-names at column 1, but got none",
+	}
+	if diff := cmp.Diff(want, r.errors); diff != "" {
+		t.Errorf("Run errors mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseExpectations(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		want    []expectation
+		err     string
+	}{
+		{"One", `"a::b"`, []expectation{{line: 1, rule: "a::b"}}, ""},
+		{"Several", `"a::b" "a::c"@12  `, []expectation{{line: 1, rule: "a::b"}, {line: 1, rule: "a::c", column: 12}}, ""},
+		{"Unquoted", `a::b`, nil, "invalid expectation"},
+		{"InvalidRule", `"A B"`, nil, "invalid rule name"},
+		{"InvalidColumn", `"a::b"@x`, nil, "invalid column"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseExpectations(test.comment, 1)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("parseExpectations got error %v, but want one containing %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var values []expectation
+			for _, e := range got {
+				values = append(values, *e)
+			}
+			if diff := cmp.Diff(test.want, values, cmp.AllowUnexported(expectation{})); diff != "" {
+				t.Errorf("parseExpectations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseSource(t *testing.T) {
+	src, err := parseSource([]byte("message A {}  // want \"a::b\"\n// want nothing\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(src.stripped), "message A {}                \n// want nothing\n"; got != want {
+		t.Errorf("parseSource stripped %q, but want %q", got, want)
+	}
+}
+
+func TestOffset(t *testing.T) {
+	content := []byte("ab\n\tcd\né f\n")
+	tests := []struct {
+		line, column int32
+		want         int
+	}{
+		{0, 0, 0},
+		{0, 2, 2},
+		{1, 8, 4},
+		{1, 9, 5},
+		{2, 2, 10},
+		{3, 0, 12},
+		{0, 3, -1},
+		{4, 0, -1},
+	}
+	for _, test := range tests {
+		if got := offset(content, test.line, test.column); got != test.want {
+			t.Errorf("offset(%d, %d) got %d, but want %d", test.line, test.column, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package lint
+
+import dpb "google.golang.org/protobuf/types/descriptorpb"
+
+// ParseErrorRuleName is the rule ID of problems reporting errors in files
+// that fail to parse, and so cannot be linted. They cannot be disabled.
+const ParseErrorRuleName RuleName = "api-linter::parse-error"
+
+// parseErrorCategory is the category of parse error problems, which are
+// errors rather than findings of a rule.
+const parseErrorCategory = "error"
+
+// NewParseErrorProblem returns a problem for an error parsing the file at
+// the given path. The line and column are one-based, or zero if unknown.
+func NewParseErrorProblem(path string, line, column int, message string) Problem {
+	p := Problem{
+		Message:  message,
+		RuleID:   ParseErrorRuleName,
+		category: parseErrorCategory,
+		path:     path,
+	}
+	if line > 0 && column > 0 {
+		p.Location = &dpb.SourceCodeInfo_Location{
+			Span: []int32{int32(line - 1), int32(column - 1), int32(column)},
+		}
+	}
+	return p
+}
@@ -0,0 +1,51 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestNewParseErrorProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   int
+		column int
+		want   []string
+	}{
+		{
+			name:   "Position",
+			line:   4,
+			column: 9,
+			want: []string{
+				"path: acme/book.proto",
+				"start_position:\n    line_number: 4\n    column_number: 9",
+				"end_position:\n    line_number: 4\n    column_number: 9",
+			},
+		},
+		{
+			name: "NoPosition",
+			want: []string{"path: acme/book.proto", "line_number: 0"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := NewParseErrorProblem("acme/book.proto", test.line, test.column, `syntax error: unexpected '}'`)
+			b, err := yaml.Marshal(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := append(test.want,
+				`message: 'syntax error: unexpected ''}'''`,
+				"rule_id: api-linter::parse-error",
+				"category: error",
+			)
+			for _, w := range want {
+				if !strings.Contains(string(b), w) {
+					t.Errorf("yaml.Marshal() got\n%s\nwant it to contain %q", b, w)
+				}
+			}
+		})
+	}
+}
@@ -16,8 +16,10 @@ package lint
 
 import (
 	"encoding/json"
+	"slices"
 
 	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -60,6 +62,13 @@ type Problem struct {
 	// The category for this problem, based on user configuration.
 	category string
 
+	// The URI of the rule documentation, if it does not come from the rule
+	// URL mappings.
+	ruleDocURI string
+
+	// The path of the file, for problems without a descriptor.
+	path string
+
 	//lint:ignore U1000 ignored via golint previously
 	noPositional struct{}
 }
@@ -76,13 +85,6 @@ func (p Problem) MarshalYAML() (interface{}, error) {
 
 // Marshal defines how to represent a serialized Problem.
 func (p Problem) marshal() interface{} {
-	// The descriptor is always set, and location may be set.
-	// If they are both set, prefer the location.
-	loc := p.Location
-	if loc == nil && p.Descriptor != nil {
-		loc = p.Descriptor.GetSourceInfo()
-	}
-
 	// Return a marshal-able structure.
 	return struct {
 		Message    string       `json:"message" yaml:"message"`
@@ -94,15 +96,110 @@ func (p Problem) marshal() interface{} {
 	}{
 		p.Message,
 		p.Suggestion,
-		fileLocationFromPBLocation(loc, p.Descriptor),
+		p.fileLocation(),
 		p.RuleID,
 		p.GetRuleURI(),
 		p.category,
 	}
 }
 
+// fileLocation returns the location of the problem in its file.
+func (p Problem) fileLocation() fileLocation {
+	// The descriptor is always set, and location may be set.
+	// If they are both set, prefer the location.
+	loc := p.Location
+	if loc == nil && p.Descriptor != nil {
+		loc = p.Descriptor.GetSourceInfo()
+	}
+	fl := fileLocationFromPBLocation(loc, p.Descriptor)
+	if p.Descriptor == nil {
+		fl.Path = p.path
+	}
+	fl.Element = p.Element()
+	fl.SourcePath = p.SourcePath()
+	return fl
+}
+
+// Element returns the fully-qualified name of the descriptor of the
+// problem, such as "acme.v1.Book.name", or an empty string for a file.
+//
+// Along with the source path, it locates problems in files without source
+// code info.
+func (p Problem) Element() string {
+	if p.Descriptor == nil {
+		return ""
+	}
+	if _, ok := p.Descriptor.(*desc.FileDescriptor); ok {
+		return ""
+	}
+	return p.Descriptor.GetFullyQualifiedName()
+}
+
+// SourcePath returns the path of the location of the problem in its file,
+// as in source code info: the path of its location if it has one, or else
+// the path of its descriptor. It does not depend on the file having source
+// code info.
+func (p Problem) SourcePath() []int32 {
+	if path := p.Location.GetPath(); len(path) > 0 {
+		return path
+	}
+	if d, ok := p.Descriptor.(interface {
+		Unwrap() protoreflect.Descriptor
+	}); ok {
+		return sourcePath(d.Unwrap())
+	}
+	return nil
+}
+
+// HasSpan returns whether the problem has a position in its file, which it
+// has unless the file has no source code info.
+func (p Problem) HasSpan() bool {
+	loc := p.Location
+	if loc == nil && p.Descriptor != nil {
+		loc = p.Descriptor.GetSourceInfo()
+	}
+	return len(loc.GetSpan()) >= 3
+}
+
+// sourcePath returns the path of a descriptor in its file, as in source code
+// info, using the field numbers of the descriptor protos.
+func sourcePath(d protoreflect.Descriptor) []int32 {
+	index := int32(d.Index())
+	_, inMessage := d.Parent().(protoreflect.MessageDescriptor)
+	switch d := d.(type) {
+	case protoreflect.MessageDescriptor:
+		if inMessage {
+			return slices.Concat(sourcePath(d.Parent()), []int32{3, index})
+		}
+		return []int32{4, index}
+	case protoreflect.EnumDescriptor:
+		if inMessage {
+			return slices.Concat(sourcePath(d.Parent()), []int32{4, index})
+		}
+		return []int32{5, index}
+	case protoreflect.FieldDescriptor:
+		switch {
+		case d.IsExtension() && inMessage:
+			return slices.Concat(sourcePath(d.Parent()), []int32{6, index})
+		case d.IsExtension():
+			return []int32{7, index}
+		}
+		return slices.Concat(sourcePath(d.Parent()), []int32{2, index})
+	case protoreflect.OneofDescriptor:
+		return slices.Concat(sourcePath(d.Parent()), []int32{8, index})
+	case protoreflect.EnumValueDescriptor, protoreflect.MethodDescriptor:
+		return slices.Concat(sourcePath(d.Parent()), []int32{2, index})
+	case protoreflect.ServiceDescriptor:
+		return []int32{6, index}
+	}
+	return nil
+}
+
 // GetRuleURI returns a URI to learn more about the problem.
 func (p Problem) GetRuleURI() string {
+	if p.ruleDocURI != "" {
+		return p.ruleDocURI
+	}
 	return getRuleURL(string(p.RuleID), ruleURLMappings)
 }
 
@@ -116,27 +213,36 @@ type position struct {
 // fileLocation describes a location in a source code file.
 //
 // Note: Positions are one-indexed, as a human counts lines or columns
-// in a file.
+// in a file. They are zero if the file has no source code info, in which
+// case the element and source path locate the problem.
 type fileLocation struct {
-	Start position `json:"start_position" yaml:"start_position"`
-	End   position `json:"end_position" yaml:"end_position"`
-	Path  string   `json:"path" yaml:"path"`
+	Start      position `json:"start_position" yaml:"start_position"`
+	End        position `json:"end_position" yaml:"end_position"`
+	Path       string   `json:"path" yaml:"path"`
+	Element    string   `json:"element,omitempty" yaml:"element,omitempty"`
+	SourcePath []int32  `json:"source_path,omitempty" yaml:"source_path,omitempty,flow"`
+}
+
+func (l fileLocation) equal(other fileLocation) bool {
+	return l.Start == other.Start && l.End == other.End && l.Path == other.Path &&
+		l.Element == other.Element && slices.Equal(l.SourcePath, other.SourcePath)
 }
 
 // fileLocationFromPBLocation returns a new fileLocation object based on a
 // protocol buffer SourceCodeInfo_Location
 func fileLocationFromPBLocation(l *dpb.SourceCodeInfo_Location, d desc.Descriptor) fileLocation {
-	// Spans are guaranteed by protobuf to have either three or four ints.
-	span := []int32{0, 0, 1}
-	if l != nil {
-		span = l.Span
-	}
-
 	var fl fileLocation
 	if d != nil {
 		fl = fileLocation{Path: d.GetFile().GetName()}
 	}
 
+	// Spans are guaranteed by protobuf to have either three or four ints,
+	// unless there is no source code info.
+	span := l.GetSpan()
+	if len(span) < 3 {
+		return fl
+	}
+
 	// If `span` has four ints; they correspond to
 	// [start line, start column, end line, end column].
 	//
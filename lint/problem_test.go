@@ -16,10 +16,13 @@ package lint
 
 import (
 	"encoding/json"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
+	"github.com/jhump/protoreflect/desc/protoparse"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 	"gopkg.in/yaml.v2"
 )
@@ -120,3 +123,101 @@ func TestProblemDescriptor(t *testing.T) {
 		})
 	}
 }
+
+func TestProblemWithoutSourceInfo(t *testing.T) {
+	p := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{"book.proto": `
+syntax = "proto3";
+package acme.v1;
+message Book {
+  string name = 1;
+}
+`}),
+	}
+	fds, err := p.ParseFiles("book.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	problem := Problem{
+		Message:    "foo bar",
+		Descriptor: fds[0].GetMessageTypes()[0].GetFields()[0],
+		RuleID:     "core::0131",
+	}
+	if problem.HasSpan() {
+		t.Errorf("HasSpan() got true, but want false")
+	}
+	serialized, err := yaml.Marshal(problem)
+	if err != nil {
+		t.Fatalf("Could not marshal Problem to YAML.")
+	}
+	for _, token := range []string{
+		"line_number: 0",
+		"path: book.proto",
+		"element: acme.v1.Book.name",
+		"source_path: [4, 0, 2, 0]",
+	} {
+		if !strings.Contains(string(serialized), token) {
+			t.Errorf("Got\n%v\nExpected `%s` to be present.", string(serialized), token)
+		}
+	}
+}
+
+func TestProblemSourcePath(t *testing.T) {
+	p := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{"library.proto": `
+syntax = "proto3";
+package acme.v1;
+import "google/protobuf/descriptor.proto";
+message Book {
+  message Chapter {
+    enum Kind {
+      KIND_UNSPECIFIED = 0;
+    }
+    oneof content {
+      string text = 1;
+    }
+  }
+  extend google.protobuf.FieldOptions {
+    string label = 50001;
+  }
+  string name = 1;
+}
+enum State {
+  STATE_UNSPECIFIED = 0;
+}
+extend google.protobuf.MessageOptions {
+  string title = 50001;
+}
+service Library {
+  rpc GetBook(Book) returns (Book);
+}
+`}),
+		IncludeSourceCodeInfo: true,
+	}
+	fds, err := p.ParseFiles("library.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := fds[0]
+	chapter := f.GetMessageTypes()[0].GetNestedMessageTypes()[0]
+	for _, d := range []desc.Descriptor{
+		f.GetMessageTypes()[0],
+		f.GetMessageTypes()[0].GetFields()[0],
+		f.GetMessageTypes()[0].GetNestedExtensions()[0],
+		chapter,
+		chapter.GetNestedEnumTypes()[0],
+		chapter.GetNestedEnumTypes()[0].GetValues()[0],
+		chapter.GetOneOfs()[0],
+		chapter.GetFields()[0],
+		f.GetEnumTypes()[0],
+		f.GetEnumTypes()[0].GetValues()[0],
+		f.GetExtensions()[0],
+		f.GetServices()[0],
+		f.GetServices()[0].GetMethods()[0],
+	} {
+		want := d.GetSourceInfo().GetPath()
+		if got := (Problem{Descriptor: d}).SourcePath(); !slices.Equal(got, want) {
+			t.Errorf("SourcePath() for %s got %v, but want %v", d.GetFullyQualifiedName(), got, want)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package lint
+
+// reservedRuleNames are the rule IDs of the problems the linter reports
+// itself, rather than a rule of a registry. They can be enabled and disabled
+// in configs like the rules.
+var reservedRuleNames = []RuleName{
+	UnusedDisableRuleName,
+	ExpiredDisableRuleName,
+	InvalidDisableRuleName,
+	StaleSuppressionRuleName,
+	ParseErrorRuleName,
+}
+
+// enabledProblems returns the problems reported by the linter itself whose
+// rule IDs the configs enable for their descriptors.
+func (configs Configs) enabledProblems(problems []Problem) []Problem {
+	var enabled []Problem
+	for _, p := range problems {
+		if configs.IsRuleEnabledForDescriptor(string(p.RuleID), p.Descriptor) {
+			enabled = append(enabled, p)
+		}
+	}
+	return enabled
+}
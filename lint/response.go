@@ -14,8 +14,57 @@
 
 package lint
 
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
 // Response describes the result returned by a rule.
 type Response struct {
 	FilePath string    `json:"file_path" yaml:"file_path"`
 	Problems []Problem `json:"problems" yaml:"problems"`
 }
+
+// SortResponses sorts responses by file path, so that the output of the
+// linter is reproducible.
+func SortResponses(responses []Response) {
+	slices.SortStableFunc(responses, func(a, b Response) int {
+		return strings.Compare(a.FilePath, b.FilePath)
+	})
+}
+
+// sortProblems sorts problems by file, position, source path and rule ID, and removes
+// identical problems: problems of the same rule at the same location, with
+// the same message.
+func sortProblems(problems []Problem) []Problem {
+	type located struct {
+		Problem
+		loc fileLocation
+	}
+	ps := make([]located, 0, len(problems))
+	for _, p := range problems {
+		ps = append(ps, located{p, p.fileLocation()})
+	}
+	slices.SortStableFunc(ps, func(a, b located) int {
+		return cmp.Or(
+			strings.Compare(a.loc.Path, b.loc.Path),
+			cmp.Compare(a.loc.Start.Line, b.loc.Start.Line),
+			cmp.Compare(a.loc.Start.Column, b.loc.Start.Column),
+			cmp.Compare(a.loc.End.Line, b.loc.End.Line),
+			cmp.Compare(a.loc.End.Column, b.loc.End.Column),
+			slices.Compare(a.loc.SourcePath, b.loc.SourcePath),
+			strings.Compare(string(a.RuleID), string(b.RuleID)),
+			strings.Compare(a.Message, b.Message),
+			strings.Compare(a.Suggestion, b.Suggestion),
+		)
+	})
+	sorted := problems[:0]
+	for i, p := range ps {
+		if i > 0 && p.loc.equal(ps[i-1].loc) && p.RuleID == ps[i-1].RuleID && p.Message == ps[i-1].Message {
+			continue
+		}
+		sorted = append(sorted, p.Problem)
+	}
+	return sorted
+}
@@ -0,0 +1,54 @@
+package lint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc/builder"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestSortProblems(t *testing.T) {
+	fd, err := builder.NewFile("test.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor.")
+	}
+	at := func(rule RuleName, message string, span ...int32) Problem {
+		return Problem{RuleID: rule, Message: message, Descriptor: fd, Location: &dpb.SourceCodeInfo_Location{Span: span}}
+	}
+	problems := []Problem{
+		at("core::0002::b", "m", 3, 0, 5),
+		at("core::0001::a", "m", 3, 0, 5),
+		at("core::0001::a", "m", 1, 4, 2, 0),
+		at("core::0001::a", "m", 1, 2, 6),
+		at("core::0001::a", "m", 3, 0, 5),
+		at("core::0001::a", "other", 3, 0, 5),
+	}
+	want := []string{
+		"core::0001::a m [1 2 6]",
+		"core::0001::a m [1 4 2 0]",
+		"core::0001::a m [3 0 5]",
+		"core::0001::a other [3 0 5]",
+		"core::0002::b m [3 0 5]",
+	}
+	var got []string
+	for _, p := range sortProblems(problems) {
+		got = append(got, string(p.RuleID)+" "+p.Message+" "+fmt.Sprint(p.Location.GetSpan()))
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("sortProblems() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSortResponses(t *testing.T) {
+	responses := []Response{{FilePath: "b.proto"}, {FilePath: "c.proto"}, {FilePath: "a.proto"}}
+	SortResponses(responses)
+	var got []string
+	for _, r := range responses {
+		got = append(got, r.FilePath)
+	}
+	if diff := cmp.Diff([]string{"a.proto", "b.proto", "c.proto"}, got); diff != "" {
+		t.Errorf("SortResponses() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -15,7 +15,6 @@
 package lint
 
 import (
-	"regexp"
 	"strings"
 
 	"github.com/jhump/protoreflect/desc"
@@ -67,6 +66,9 @@ type FileRule struct {
 	noPositional struct{}
 
 	RuleType *RuleType
+
+	// Metadata optionally describes the rule.
+	Metadata RuleMetadata
 }
 
 // GetRuleType returns the type of a rule.
@@ -77,6 +79,11 @@ func (r *FileRule) GetRuleType() RuleType {
 	return *r.RuleType
 }
 
+// GetMetadata returns the metadata of a rule.
+func (r *FileRule) GetMetadata() RuleMetadata {
+	return r.Metadata
+}
+
 // GetName returns the name of the rule.
 func (r *FileRule) GetName() RuleName {
 	return r.Name
@@ -109,6 +116,9 @@ type MessageRule struct {
 	noPositional struct{}
 
 	RuleType *RuleType
+
+	// Metadata optionally describes the rule.
+	Metadata RuleMetadata
 }
 
 // GetRuleType returns the type of a rule.
@@ -119,6 +129,11 @@ func (r *MessageRule) GetRuleType() RuleType {
 	return *r.RuleType
 }
 
+// GetMetadata returns the metadata of a rule.
+func (r *MessageRule) GetMetadata() RuleMetadata {
+	return r.Metadata
+}
+
 // GetName returns the name of the rule.
 func (r *MessageRule) GetName() RuleName {
 	return r.Name
@@ -156,6 +171,9 @@ type FieldRule struct {
 	noPositional struct{}
 
 	RuleType *RuleType
+
+	// Metadata optionally describes the rule.
+	Metadata RuleMetadata
 }
 
 // GetRuleType returns the type of a rule.
@@ -166,6 +184,11 @@ func (r *FieldRule) GetRuleType() RuleType {
 	return *r.RuleType
 }
 
+// GetMetadata returns the metadata of a rule.
+func (r *FieldRule) GetMetadata() RuleMetadata {
+	return r.Metadata
+}
+
 // GetName returns the name of the rule.
 func (r *FieldRule) GetName() RuleName {
 	return r.Name
@@ -205,6 +228,9 @@ type ServiceRule struct {
 	noPositional struct{}
 
 	RuleType *RuleType
+
+	// Metadata optionally describes the rule.
+	Metadata RuleMetadata
 }
 
 // GetRuleType returns the type of a rule.
@@ -215,6 +241,11 @@ func (r *ServiceRule) GetRuleType() RuleType {
 	return *r.RuleType
 }
 
+// GetMetadata returns the metadata of a rule.
+func (r *ServiceRule) GetMetadata() RuleMetadata {
+	return r.Metadata
+}
+
 // GetName returns the name of the rule.
 func (r *ServiceRule) GetName() RuleName {
 	return r.Name
@@ -249,6 +280,9 @@ type MethodRule struct {
 	noPositional struct{}
 
 	RuleType *RuleType
+
+	// Metadata optionally describes the rule.
+	Metadata RuleMetadata
 }
 
 // GetRuleType returns the type of a rule.
@@ -259,6 +293,11 @@ func (r *MethodRule) GetRuleType() RuleType {
 	return *r.RuleType
 }
 
+// GetMetadata returns the metadata of a rule.
+func (r *MethodRule) GetMetadata() RuleMetadata {
+	return r.Metadata
+}
+
 // GetName returns the name of the rule.
 func (r *MethodRule) GetName() RuleName {
 	return r.Name
@@ -295,6 +334,9 @@ type EnumRule struct {
 	noPositional struct{}
 
 	RuleType *RuleType
+
+	// Metadata optionally describes the rule.
+	Metadata RuleMetadata
 }
 
 // GetRuleType returns the type of a rule.
@@ -305,6 +347,11 @@ func (r *EnumRule) GetRuleType() RuleType {
 	return *r.RuleType
 }
 
+// GetMetadata returns the metadata of a rule.
+func (r *EnumRule) GetMetadata() RuleMetadata {
+	return r.Metadata
+}
+
 // GetName returns the name of the rule.
 func (r *EnumRule) GetName() RuleName {
 	return r.Name
@@ -341,6 +388,9 @@ type EnumValueRule struct {
 	noPositional struct{}
 
 	RuleType *RuleType
+
+	// Metadata optionally describes the rule.
+	Metadata RuleMetadata
 }
 
 // GetRuleType returns the type of a rule.
@@ -351,6 +401,11 @@ func (r *EnumValueRule) GetRuleType() RuleType {
 	return *r.RuleType
 }
 
+// GetMetadata returns the metadata of a rule.
+func (r *EnumValueRule) GetMetadata() RuleMetadata {
+	return r.Metadata
+}
+
 // GetName returns the name of the rule.
 func (r *EnumValueRule) GetName() RuleName {
 	return r.Name
@@ -393,6 +448,9 @@ type DescriptorRule struct {
 	noPositional struct{}
 
 	RuleType *RuleType
+
+	// Metadata optionally describes the rule.
+	Metadata RuleMetadata
 }
 
 // GetRuleType returns the type of a rule.
@@ -403,6 +461,11 @@ func (r *DescriptorRule) GetRuleType() RuleType {
 	return *r.RuleType
 }
 
+// GetMetadata returns the metadata of a rule.
+func (r *DescriptorRule) GetMetadata() RuleMetadata {
+	return r.Metadata
+}
+
 // GetName returns the name of the rule.
 func (r *DescriptorRule) GetName() RuleName {
 	return r.Name
@@ -455,16 +518,6 @@ func (r *DescriptorRule) Lint(fd *desc.FileDescriptor) []Problem {
 	return problems
 }
 
-var disableRuleNameRegex = regexp.MustCompile(`api-linter:\s*(.+)\s*=\s*disabled`)
-
-func extractDisabledRuleName(commentLine string) string {
-	match := disableRuleNameRegex.FindStringSubmatch(commentLine)
-	if len(match) > 0 {
-		return match[1]
-	}
-	return ""
-}
-
 func getLeadingComments(d desc.Descriptor) string {
 	if sourceInfo := d.GetSourceInfo(); sourceInfo != nil {
 		return sourceInfo.GetLeadingComments()
@@ -513,6 +566,19 @@ func getAllEnums(f *desc.FileDescriptor) (enums []*desc.EnumDescriptor) {
 //
 // Taken from https://github.com/jhump/protoreflect/issues/215
 func fileHeader(fd *desc.FileDescriptor) string {
+	firstLoc := fileHeaderLocation(fd)
+	if firstLoc == nil {
+		return ""
+	}
+	if len(firstLoc.LeadingDetachedComments) > 0 {
+		return strings.Join(firstLoc.LeadingDetachedComments, "\n")
+	}
+	return firstLoc.GetLeadingComments()
+}
+
+// fileHeaderLocation returns the location whose comments are the comment at
+// the top of the file, or nil if there is none.
+func fileHeaderLocation(fd *desc.FileDescriptor) *dpb.SourceCodeInfo_Location {
 	var firstLoc *dpb.SourceCodeInfo_Location
 	var firstSpan int64
 
@@ -538,13 +604,7 @@ func fileHeader(fd *desc.FileDescriptor) string {
 			firstSpan = currSpan
 		}
 	}
-	if firstLoc == nil {
-		return ""
-	}
-	if len(firstLoc.LeadingDetachedComments) > 0 {
-		return strings.Join(firstLoc.LeadingDetachedComments, "\n")
-	}
-	return firstLoc.GetLeadingComments()
+	return firstLoc
 }
 
 func asPos(span []int32) int64 {
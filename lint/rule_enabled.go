@@ -15,7 +15,7 @@
 package lint
 
 import (
-	"strings"
+	"fmt"
 
 	"github.com/jhump/protoreflect/desc"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
@@ -67,70 +67,132 @@ var descriptorDisableChecks = []func(d desc.Descriptor) bool{
 // augment the set of commentLines.
 func ruleIsEnabled(rule ProtoRule, d desc.Descriptor, l *dpb.SourceCodeInfo_Location,
 	aliasMap map[string]string, ignoreCommentDisables bool) bool {
-	if rule.GetRuleType() == MustRule {
-		return true
-	}
+	enabled, _ := traceRuleIsEnabled(rule, d, l, aliasMap, ignoreCommentDisables, nil)
+	return enabled
+}
 
-	// If the rule is disabled because of something on the descriptor itself
-	// (e.g. a deprecated annotation), address that.
-	for _, mustDisable := range descriptorDisableChecks {
-		// The only thing the disable functions can do is force a rule to
-		// be disabled. (They can not force a rule to be enabled.)
-		if mustDisable(d) {
-			return false
-		}
+// traceRuleIsEnabled is ruleIsEnabled, reporting every step that decides
+// the outcome to the given tracer. If a disable comment disabled the rule,
+// it is returned as well.
+func traceRuleIsEnabled(rule ProtoRule, d desc.Descriptor, l *dpb.SourceCodeInfo_Location,
+	aliasMap map[string]string, ignoreCommentDisables bool, trace enablementTracer) (bool, *disableDirective) {
+	if rule.GetRuleType() == MustRule {
+		trace.step("rule type", "must rules cannot be disabled on descriptors", true)
+		return true, nil
 	}
 
 	if !ignoreCommentDisables {
-		if ruleIsDisabledByComments(rule, d, l, aliasMap) {
-			return false
+		if directive := ruleIsDisabledOnLine(rule, d, l, aliasMap); directive != nil {
+			trace.step(descriptorName(d), fmt.Sprintf("disabled on its line by the comment %q", directive.text), false)
+			return false, directive
 		}
 	}
 
 	// The rule may have been disabled on a parent. (For example, a field rule
 	// may be disabled at the message level to cover all fields in the message).
+	// The comments of the closest descriptor mentioning the rule decide,
+	// so that a descriptor may re-enable a rule disabled on a parent.
 	//
-	// Do not pass the source code location here, the source location in relation
-	// to the parent is not helpful.
-	if parent := d.GetParent(); parent != nil {
-		return ruleIsEnabled(rule, parent, nil, aliasMap, ignoreCommentDisables)
+	// Only use the source code location for the descriptor itself, the source
+	// location in relation to the parent is not helpful.
+	commentsDecided := ignoreCommentDisables
+	for ; d != nil; d, l = d.GetParent(), nil {
+		// If the rule is disabled because of something on the descriptor itself
+		// (e.g. a deprecated annotation), address that.
+		for _, mustDisable := range descriptorDisableChecks {
+			// The only thing the disable functions can do is force a rule to
+			// be disabled. (They can not force a rule to be enabled.)
+			if mustDisable(d) {
+				detail := "disabled by a descriptor check"
+				if disableDeprecated(d) {
+					detail = "the descriptor is deprecated"
+				}
+				trace.step(descriptorName(d), detail, false)
+				return false, nil
+			}
+		}
+
+		if commentsDecided {
+			continue
+		}
+		if directive := ruleDirectiveInComments(rule, d, l, aliasMap); directive != nil {
+			if !directive.enable {
+				trace.step(descriptorName(d), fmt.Sprintf("disabled by the comment %q", directive.text), false)
+				return false, directive
+			}
+			trace.step(descriptorName(d), fmt.Sprintf("enabled by the comment %q", directive.text), true)
+			commentsDecided = true
+		}
 	}
 
-	return true
+	return true, nil
 }
 
-// ruleIsDisabledByComments returns true if the rule has been disabled
-// by comments in the file or leading the element.
-func ruleIsDisabledByComments(rule ProtoRule, d desc.Descriptor, l *dpb.SourceCodeInfo_Location, aliasMap map[string]string) bool {
-	// Must rules cannot be disabled by the linter.
-	// Some rules have a legacy name. We add it to the check list.
-	ruleName := string(rule.GetName())
-	names := []string{ruleName, aliasMap[ruleName]}
+// ruleDirectiveInComments returns the first directive in the comments of
+// the file or the element that disables or enables the rule, or nil if
+// there is none. Directives for the next line are not considered.
+func ruleDirectiveInComments(rule ProtoRule, d desc.Descriptor, l *dpb.SourceCodeInfo_Location, aliasMap map[string]string) *disableDirective {
+	directives := locationDirectives(l)
+	directives = append(directives, descriptorDirectives(d)...)
+	for _, directive := range directives {
+		if !directive.nextLine && directiveMatchesRule(directive, rule, aliasMap) {
+			return &directive
+		}
+	}
+	return nil
+}
 
-	commentLines := []string{}
-	if l != nil {
-		commentLines = append(commentLines, strings.Split(l.GetLeadingComments(), "\n")...)
+// ruleIsDisabledOnLine returns the disable-next-line directive that disables
+// the rule on the line where the problem starts, or nil if there is none.
+//
+// The problem starts on the line of the source code location if it is set,
+// and on the line of the descriptor otherwise.
+func ruleIsDisabledOnLine(rule ProtoRule, d desc.Descriptor, l *dpb.SourceCodeInfo_Location, aliasMap map[string]string) *disableDirective {
+	var directives []disableDirective
+	for _, directive := range locationDirectives(l) {
+		if !directive.trailing {
+			directives = append(directives, directive)
+		}
 	}
-	if f, ok := d.(*desc.FileDescriptor); ok {
-		commentLines = append(commentLines, strings.Split(fileHeader(f), "\n")...)
-	} else {
-		commentLines = append(commentLines, strings.Split(getLeadingComments(d), "\n")...)
+	if _, isFile := d.(*desc.FileDescriptor); !isFile && (l == nil || sameLine(l, d.GetSourceInfo())) {
+		for _, directive := range descriptorDirectives(d) {
+			if !directive.trailing {
+				directives = append(directives, directive)
+			}
+		}
 	}
-	disabledRules := []string{}
-	for _, commentLine := range commentLines {
-		r := extractDisabledRuleName(commentLine)
-		if r != "" {
-			disabledRules = append(disabledRules, r)
+	for _, directive := range directives {
+		if directive.nextLine && directiveMatchesRule(directive, rule, aliasMap) {
+			return &directive
 		}
 	}
+	return nil
+}
 
-	for _, name := range names {
-		if matchRule(name, disabledRules...) {
-			return true
-		}
+// sameLine returns true if both source code locations start on the same line.
+func sameLine(a, b *dpb.SourceCodeInfo_Location) bool {
+	return len(a.GetSpan()) > 0 && len(b.GetSpan()) > 0 && a.GetSpan()[0] == b.GetSpan()[0]
+}
+
+// directiveMatchesRule returns true if the directive is in effect and refers
+// to the rule, or to its legacy name.
+func directiveMatchesRule(directive disableDirective, rule ProtoRule, aliasMap map[string]string) bool {
+	// Expired directives, and directives with an invalid expiry date, do not
+	// disable anything.
+	if !directive.inEffect() {
+		return false
 	}
+	ruleName := string(rule.GetName())
+	return matchRule(ruleName, directive.rule) || matchRule(aliasMap[ruleName], directive.rule)
+}
 
-	return false
+// descriptorName returns the fully qualified name of the descriptor, or the
+// file name for a file.
+func descriptorName(d desc.Descriptor) string {
+	if f, ok := d.(*desc.FileDescriptor); ok {
+		return f.GetName()
+	}
+	return d.GetFullyQualifiedName()
 }
 
 func NewRuleType(rt RuleType) *RuleType {
@@ -204,3 +204,72 @@ func TestRuleIsEnabledDeprecated(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleIsEnabledDirectives(t *testing.T) {
+	rule := &FieldRule{
+		Name: RuleName("test::rule"),
+		LintField: func(f *desc.FieldDescriptor) []Problem {
+			return nil
+		},
+	}
+	f := parseTestProto(t, `syntax = "proto3";
+
+package test;
+
+message Foo {
+  // api-linter: disable-next-line test::rule
+  string a = 1;
+  string b = 2; // api-linter: test::rule=disabled
+  // api-linter: other=disabled test::rule=disabled
+  string c = 3;
+  // api-linter: other,test::rule=disabled
+  string d = 4;
+  string e = 5;
+}
+
+// api-linter: disable-next-line test
+message Bar {
+  string f = 1;
+}
+
+// api-linter: test=disabled
+message Baz {
+  string g = 1;
+  // api-linter: test::rule=enabled
+  string h = 2;
+  // api-linter: test::rule=enabled
+  message Qux {
+    // api-linter: test::rule=disabled
+    string i = 1;
+    string j = 2;
+  }
+}
+`)
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"test.Foo.a", false},
+		{"test.Foo.b", false},
+		{"test.Foo.c", false},
+		{"test.Foo.d", false},
+		{"test.Foo.e", true},
+		{"test.Bar", false},
+		{"test.Bar.f", true},
+		{"test.Baz.g", false},
+		{"test.Baz.h", true},
+		{"test.Baz.Qux.i", false},
+		{"test.Baz.Qux.j", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := f.FindSymbol(test.name)
+			if d == nil {
+				t.Fatalf("Symbol %q not found", test.name)
+			}
+			if got := ruleIsEnabled(rule, d, nil, nil, false); got != test.want {
+				t.Errorf("ruleIsEnabled got %v, but want %v", got, test.want)
+			}
+		})
+	}
+}
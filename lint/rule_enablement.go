@@ -0,0 +1,99 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// EnablementStep is one step in deciding whether a rule is enabled.
+type EnablementStep struct {
+	// Source is what was consulted, such as "default", "config[2]", or the
+	// name of a descriptor whose comments or options were checked.
+	Source string `json:"source" yaml:"source"`
+	// Detail describes the effect of the source on the rule.
+	Detail string `json:"detail" yaml:"detail"`
+	// Enabled is whether the rule is enabled after this step.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+// Enablement explains whether a rule is enabled for a file or a descriptor.
+type Enablement struct {
+	Rule    RuleName         `json:"rule" yaml:"rule"`
+	Path    string           `json:"path" yaml:"path"`
+	Element string           `json:"element,omitempty" yaml:"element,omitempty"`
+	Enabled bool             `json:"enabled" yaml:"enabled"`
+	Steps   []EnablementStep `json:"steps" yaml:"steps"`
+}
+
+// String returns a human-readable explanation, one step per line.
+func (e Enablement) String() string {
+	var b strings.Builder
+	target := e.Path
+	if e.Element != "" {
+		target = e.Element + " in " + e.Path
+	}
+	fmt.Fprintf(&b, "%s is %s for %s\n", e.Rule, enabledString(e.Enabled), target)
+	for i, s := range e.Steps {
+		fmt.Fprintf(&b, "  %d. %s: %s (%s)\n", i+1, s.Source, s.Detail, enabledString(s.Enabled))
+	}
+	return b.String()
+}
+
+func enabledString(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// enablementTracer receives the steps of deciding whether a rule is enabled.
+// A nil tracer discards them.
+type enablementTracer func(EnablementStep)
+
+func (t enablementTracer) step(source, detail string, enabled bool) {
+	if t != nil {
+		t(EnablementStep{Source: source, Detail: detail, Enabled: enabled})
+	}
+}
+
+// ExplainRuleEnablement explains whether the named rule runs for the file
+// containing the given descriptor, and whether problems it reports on the
+// descriptor are kept, following the same steps as linting does.
+func (l *Linter) ExplainRuleEnablement(name RuleName, d desc.Descriptor) (Enablement, error) {
+	rule, ok := l.rules[name]
+	if !ok {
+		return Enablement{}, fmt.Errorf("rule %q is not registered", name)
+	}
+
+	e := Enablement{Rule: name, Path: d.GetFile().GetName()}
+	if _, isFile := d.(*desc.FileDescriptor); !isFile {
+		e.Element = d.GetFullyQualifiedName()
+	}
+	trace := func(s EnablementStep) {
+		e.Steps = append(e.Steps, s)
+	}
+
+	e.Enabled = l.configs.traceIsRuleEnabled(string(name), descriptorTarget(d), trace)
+	if !e.Enabled {
+		return e, nil
+	}
+	if l.ignoreCommentDisables {
+		trace(EnablementStep{Source: "linter", Detail: "disable comments are ignored", Enabled: true})
+	}
+	e.Enabled, _ = traceRuleIsEnabled(rule, d, nil, aliasMap, l.ignoreCommentDisables, trace)
+	if i := l.suppressions.suppressing(rule, d); e.Enabled && i >= 0 {
+		e.Enabled = false
+		sup := l.suppressions[i]
+		trace(EnablementStep{
+			Source:  fmt.Sprintf("suppressions[%d]", i),
+			Detail:  fmt.Sprintf("suppressed on %q: %s", sup.Element, sup.Reason),
+			Enabled: false,
+		})
+	}
+	if e.Enabled && rule.GetRuleType() != MustRule {
+		trace(EnablementStep{Source: "comments", Detail: "no descriptor or comment disables the rule", Enabled: true})
+	}
+	return e, nil
+}
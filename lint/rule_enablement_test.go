@@ -0,0 +1,110 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/builder"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestLinter_ExplainRuleEnablement(t *testing.T) {
+	rule := &FieldRule{
+		Name:      NewRuleName(111, "test"),
+		LintField: func(*desc.FieldDescriptor) []Problem { return nil },
+	}
+	registry := NewRuleRegistry()
+	if err := registry.Register(111, rule); err != nil {
+		t.Fatal(err)
+	}
+
+	deprecated := true
+	f, err := builder.NewFile("test.proto").AddMessage(
+		builder.NewMessage("Disabled").SetComments(builder.Comments{
+			LeadingComment: "(-- api-linter: core::0111::test=disabled --)",
+		}).AddField(builder.NewField("foo", builder.FieldTypeString())),
+	).AddMessage(
+		builder.NewMessage("Deprecated").SetOptions(&dpb.MessageOptions{Deprecated: &deprecated}),
+	).AddMessage(
+		builder.NewMessage("Enabled"),
+	).Build()
+	if err != nil {
+		t.Fatalf("Failed to build file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		configs Configs
+		element string
+		want    Enablement
+	}{
+		{
+			name:    "EnabledByDefault",
+			element: "Enabled",
+			want: Enablement{
+				Rule: rule.Name, Path: "test.proto", Element: "Enabled", Enabled: true,
+				Steps: []EnablementStep{
+					{"default", "enabled by default", true},
+					{"comments", "no descriptor or comment disables the rule", true},
+				},
+			},
+		},
+		{
+			name: "DisabledByConfig",
+			configs: Configs{
+				{IncludedPaths: []string{"other.proto"}, EnabledRules: []string{"core"}},
+				{DisabledRules: []string{"core::0111"}},
+			},
+			want: Enablement{
+				Rule: rule.Name, Path: "test.proto", Enabled: false,
+				Steps: []EnablementStep{
+					{"default", "enabled by default", true},
+					{"config[0]", `does not apply to "test.proto"`, true},
+					{"config[1]", `disabled_rules contains "core::0111"`, false},
+				},
+			},
+		},
+		{
+			name:    "DisabledByParentComment",
+			element: "Disabled.foo",
+			want: Enablement{
+				Rule: rule.Name, Path: "test.proto", Element: "Disabled.foo", Enabled: false,
+				Steps: []EnablementStep{
+					{"default", "enabled by default", true},
+					{"Disabled", `disabled by the comment "(-- api-linter: core::0111::test=disabled --)"`, false},
+				},
+			},
+		},
+		{
+			name:    "DisabledByDeprecation",
+			element: "Deprecated",
+			want: Enablement{
+				Rule: rule.Name, Path: "test.proto", Element: "Deprecated", Enabled: false,
+				Steps: []EnablementStep{
+					{"default", "enabled by default", true},
+					{"Deprecated", "the descriptor is deprecated", false},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var d desc.Descriptor = f
+			if test.element != "" {
+				d = f.FindSymbol(test.element)
+			}
+			got, err := New(registry, test.configs).ExplainRuleEnablement(rule.Name, d)
+			if err != nil {
+				t.Fatalf("ExplainRuleEnablement returned error: %v", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ExplainRuleEnablement mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := New(registry, nil).ExplainRuleEnablement("core::0111::unknown", f); err == nil {
+		t.Error("ExplainRuleEnablement expects an error for an unknown rule")
+	}
+}
@@ -0,0 +1,54 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// FallibleRule is implemented by rules which can fail, such as the rules of
+// plugins. The linter runs them with LintWithError instead of Lint, and
+// reports their errors as a *RuleError.
+type FallibleRule interface {
+	LintWithError(fd *desc.FileDescriptor) ([]Problem, error)
+}
+
+// RuleError describes a rule that failed while linting a file, either by
+// panicking, by returning an error, or by returning an invalid problem. The
+// problems of the other rules are still reported.
+type RuleError struct {
+	// Rule is the name of the failing rule.
+	Rule RuleName
+	// File is the name of the file being linted.
+	File string
+	// Panic is the value recovered from the panic of the rule, if it
+	// panicked.
+	Panic any
+	// Stack is the stack trace of the panic, if the rule panicked.
+	Stack []byte
+	// Err is the error returned by the rule, if it is a FallibleRule.
+	Err error
+	// Message describes the failure, if the rule did not panic nor return
+	// an error.
+	Message string
+}
+
+func (e *RuleError) Error() string {
+	if e.Panic != nil {
+		return fmt.Sprintf("%s: rule %q panicked: %v", e.File, e.Rule, e.Panic)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("%s: rule %q failed: %v", e.File, e.Rule, e.Err)
+	}
+	return fmt.Sprintf("%s: rule %q %s", e.File, e.Rule, e.Message)
+}
+
+// Unwrap returns the error returned by the rule, or the value the rule
+// panicked with, if it is an error.
+func (e *RuleError) Unwrap() error {
+	if e.Err != nil {
+		return e.Err
+	}
+	err, _ := e.Panic.(error)
+	return err
+}
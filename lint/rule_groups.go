@@ -1,29 +1,62 @@
 package lint
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+)
 
-// A list of functions, each of which returns the group name for the given AIP
-// number and if no group is found, returns an empty string.
+// ruleGroup is a group of rules, such as "core", with a function returning
+// the name of the group for its AIP numbers and an empty string for others.
+type ruleGroup struct {
+	name  string
+	group func(int) string
+}
+
+// The registered groups.
 // NOTE: the list will be evaluated in the FILO order.
+var aipGroups = []ruleGroup{
+	{"core", aipCoreGroup},
+	{"client-libraries", aipClientLibrariesGroup},
+	{"cloud", aipCloudGroup},
+}
+
+var groupNameValidator = regexp.MustCompile("^[a-z0-9][a-z0-9-]*$")
+
+// RegisterGroup registers a group of rules, with a function returning the
+// name of the group for the AIP numbers of the group, and an empty string
+// for others. Groups registered later take precedence over earlier ones for
+// the AIP numbers they share, so a group may claim numbers of the built-in
+// groups.
 //
-// At Google, we inject additional group naming functions into this list.
-// Example: google_aip_groups.go
-// package lint
+// Groups must be registered before rules are named or registered with their
+// AIP number, typically from an init function:
 //
 //	func init() {
-//	  aipGroups = append(aipGroups, aipInternalGroup)
-//	}
-//
-//	func aipInternalGroup(aip int) string {
-//	  if aip > 9000 {
-//		   return "internal"
+//	  if err := lint.RegisterGroup("acme", func(aip int) string {
+//	    if aip >= 9000 {
+//	      return "acme"
+//	    }
+//	    return ""
+//	  }); err != nil {
+//	    panic(err)
 //	  }
-//	  return ""
 //	}
-var aipGroups = []func(int) string{
-	aipCoreGroup,
-	aipClientLibrariesGroup,
-	aipCloudGroup,
+//
+// Return an error if the name is invalid or already registered.
+func RegisterGroup(name string, group func(aip int) string) error {
+	if !groupNameValidator.MatchString(name) {
+		return fmt.Errorf("invalid group name %q", name)
+	}
+	if group == nil {
+		return fmt.Errorf("group %q has no AIP numbers", name)
+	}
+	for _, g := range aipGroups {
+		if g.name == name {
+			return fmt.Errorf("group %q is already registered", name)
+		}
+	}
+	aipGroups = append(aipGroups, ruleGroup{name, group})
+	return nil
 }
 
 func aipCoreGroup(aip int) string {
@@ -48,12 +81,17 @@ func aipCloudGroup(aip int) string {
 }
 
 // getRuleGroup takes an AIP number and returns the appropriate group.
-// It panics if no group is found.
-func getRuleGroup(aip int, groups []func(int) string) string {
+// Return an error if no group is found.
+func getRuleGroup(aip int, groups []ruleGroup) (string, error) {
 	for i := len(groups) - 1; i >= 0; i-- {
-		if group := groups[i](aip); group != "" {
-			return group
+		switch group := groups[i].group(aip); group {
+		case "":
+			continue
+		case groups[i].name:
+			return group, nil
+		default:
+			return "", fmt.Errorf("%w: group %q returned %q for AIP %d", errInvalidRuleGroup, groups[i].name, group, aip)
 		}
 	}
-	panic(fmt.Sprintf("Invalid AIP number %d: no available group.", aip))
+	return "", fmt.Errorf("%w: no group for AIP %d", errInvalidRuleGroup, aip)
 }
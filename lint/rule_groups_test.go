@@ -1,6 +1,8 @@
 package lint
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -42,14 +44,21 @@ func TestAIPClientLibrariesGroup(t *testing.T) {
 	}
 }
 
-func TestGetRuleGroupPanic(t *testing.T) {
-	var groups []func(int) string
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("getRuleGroup did not panic")
-		}
-	}()
-	getRuleGroup(0, groups)
+func TestGetRuleGroupError(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups []ruleGroup
+	}{
+		{"NoGroup", nil},
+		{"WrongName", []ruleGroup{{"one", func(int) string { return "two" }}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := getRuleGroup(1, test.groups); !errors.Is(err, errInvalidRuleGroup) {
+				t.Errorf("getRuleGroup got error %v, but want %v", err, errInvalidRuleGroup)
+			}
+		})
+	}
 }
 
 func TestGetRuleGroup(t *testing.T) {
@@ -65,9 +74,9 @@ func TestGetRuleGroup(t *testing.T) {
 		}
 		return ""
 	}
-	groups := []func(int) string{
-		groupOne,
-		groupTwo,
+	groups := []ruleGroup{
+		{"ONE", groupOne},
+		{"TWO", groupTwo},
 	}
 
 	tests := []struct {
@@ -81,9 +90,71 @@ func TestGetRuleGroup(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			if got := getRuleGroup(test.aip, groups); got != test.group {
-				t.Errorf("getRuleGroup(%d) got %s, but want %s", test.aip, got, test.group)
+			if got, err := getRuleGroup(test.aip, groups); err != nil || got != test.group {
+				t.Errorf("getRuleGroup(%d) got %s, %v, but want %s", test.aip, got, err, test.group)
 			}
 		})
 	}
 }
+
+func TestRegisterGroup(t *testing.T) {
+	defer func(groups []ruleGroup, mappings []func(string) string) {
+		aipGroups, ruleURLMappings = groups, mappings
+	}(aipGroups, ruleURLMappings)
+
+	acme := func(aip int) string {
+		if aip >= 9000 {
+			return "acme"
+		}
+		return ""
+	}
+	if err := RegisterGroup("acme", acme); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := NewRuleName(9001, "field-names"), RuleName("acme::9001::field-names"); got != want {
+		t.Errorf("NewRuleName got %q, but want %q", got, want)
+	}
+	if _, err := ParseRuleName(8999, "field-names"); !errors.Is(err, errInvalidRuleGroup) {
+		t.Errorf("ParseRuleName got error %v for an AIP without group, but want %v", err, errInvalidRuleGroup)
+	}
+	if err := NewRuleRegistry().Register(9001, &FieldRule{Name: "acme::9001::field-names"}); err != nil {
+		t.Errorf("Register got error %v for a rule of a registered group", err)
+	}
+	if err := NewRuleRegistry().Register(8999, &FieldRule{Name: "acme::8999::field-names"}); !errors.Is(err, errInvalidRuleGroup) {
+		t.Errorf("Register got error %v for an AIP without group, but want %v", err, errInvalidRuleGroup)
+	}
+
+	for _, test := range []struct {
+		name  string
+		group string
+		fn    func(int) string
+	}{
+		{"Duplicate", "acme", acme},
+		{"InvalidName", "Acme::Corp", acme},
+		{"Nil", "other", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if err := RegisterGroup(test.group, test.fn); err == nil {
+				t.Errorf("RegisterGroup(%q) expects an error", test.group)
+			}
+		})
+	}
+
+	if err := RegisterRuleURLMapping("acme", func(name string) string {
+		return "https://acme.example.com/lint/" + strings.ReplaceAll(name, "::", "/")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"acme::9001::field-names":     "https://acme.example.com/lint/acme/9001/field-names",
+		"core::0131::http-body":       "https://linter.aep.dev/131/http-body",
+		"acmecorp::9001::field-names": "",
+	} {
+		if got := getRuleURL(name, ruleURLMappings); got != want {
+			t.Errorf("getRuleURL(%q) got %q, but want %q", name, got, want)
+		}
+	}
+	if err := RegisterRuleURLMapping("unknown", func(string) string { return "" }); !errors.Is(err, errInvalidRuleGroup) {
+		t.Errorf("RegisterRuleURLMapping got error %v for an unknown group, but want %v", err, errInvalidRuleGroup)
+	}
+}
@@ -0,0 +1,96 @@
+package lint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RuleMetadata describes a rule. Every field is optional.
+type RuleMetadata struct {
+	// Name is the name of the rule.
+	Name RuleName `json:"name" yaml:"name"`
+	// Description is a short description of what the rule checks.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// AEP is the number of the AEP mandating the rule, if any.
+	AEP int `json:"aep,omitempty" yaml:"aep,omitempty"`
+	// AEPTitle is the title of the AEP.
+	AEPTitle string `json:"aep_title,omitempty" yaml:"aep_title,omitempty"`
+	// Type is "must", "should" or "may", or empty if not categorized.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Fixable is true if the rule suggests fixes for its problems.
+	Fixable bool `json:"fixable,omitempty" yaml:"fixable,omitempty"`
+	// DefaultEnabled is true if the rule is enabled without any config.
+	DefaultEnabled bool `json:"default_enabled" yaml:"default_enabled"`
+	// DocURL is the URL of the rule documentation.
+	DocURL string `json:"doc_url,omitempty" yaml:"doc_url,omitempty"`
+}
+
+// DescribedRule is implemented by rules which provide metadata. The rule
+// structs of this package implement it with their Metadata field.
+type DescribedRule interface {
+	GetMetadata() RuleMetadata
+}
+
+// GetRuleMetadata returns the metadata of a rule. The metadata the rule
+// provides is completed with what can be derived from its name and type.
+func GetRuleMetadata(rule ProtoRule) RuleMetadata {
+	var m RuleMetadata
+	if d, ok := rule.(DescribedRule); ok {
+		m = d.GetMetadata()
+	}
+	m.Name = rule.GetName()
+	if m.AEP == 0 {
+		m.AEP = ruleAEP(m.Name)
+	}
+	if m.Type == "" {
+		m.Type = RuleTypeString(rule.GetRuleType())
+	}
+	m.DefaultEnabled = matchingRule(string(m.Name), defaultDisabledRules...) == ""
+	m.DocURL = RuleDocURL(rule, "")
+	return m
+}
+
+// ruleAEP returns the AEP number in a rule name such as
+// "core::0131::http-body", or 0 if there is none.
+func ruleAEP(name RuleName) int {
+	parts := strings.Split(string(name), nameSeparator)
+	if len(parts) < 3 {
+		return 0
+	}
+	aep, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0
+	}
+	return aep
+}
+
+// RuleTypeString returns "must", "should" or "may" for a rule type, or an
+// empty string if the rule is not categorized.
+func RuleTypeString(t RuleType) string {
+	switch t {
+	case MustRule:
+		return "must"
+	case ShouldRule:
+		return "should"
+	case MayRule:
+		return "may"
+	}
+	return ""
+}
+
+// ParseRuleType returns the rule type for "must", "should" or "may", or
+// NotCategorizedRule for an empty string.
+func ParseRuleType(s string) (RuleType, error) {
+	switch s {
+	case "":
+		return NotCategorizedRule, nil
+	case "must":
+		return MustRule, nil
+	case "should":
+		return ShouldRule, nil
+	case "may":
+		return MayRule, nil
+	}
+	return NotCategorizedRule, fmt.Errorf("unknown rule type %q", s)
+}
@@ -0,0 +1,73 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetRuleMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		rule ProtoRule
+		want RuleMetadata
+	}{
+		{
+			name: "Derived",
+			rule: &MessageRule{Name: "core::0131::http-body", RuleType: NewRuleType(MustRule)},
+			want: RuleMetadata{
+				Name:           "core::0131::http-body",
+				AEP:            131,
+				Type:           "must",
+				DefaultEnabled: true,
+				DocURL:         "https://linter.aep.dev/131/http-body",
+			},
+		},
+		{
+			name: "Provided",
+			rule: &FieldRule{
+				Name: "cloud::2500::field-names",
+				Metadata: RuleMetadata{
+					Description: "Field names must be short.",
+					AEPTitle:    "Field names",
+					Type:        "should",
+					Fixable:     true,
+					DocURL:      "https://example.com/field-names",
+				},
+			},
+			want: RuleMetadata{
+				Name:        "cloud::2500::field-names",
+				Description: "Field names must be short.",
+				AEP:         2500,
+				AEPTitle:    "Field names",
+				Type:        "should",
+				Fixable:     true,
+				DocURL:      "https://example.com/field-names",
+			},
+		},
+		{
+			name: "NoAEP",
+			rule: &FileRule{Name: "acme::file-names"},
+			want: RuleMetadata{Name: "acme::file-names", DefaultEnabled: true},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, GetRuleMetadata(test.rule)); diff != "" {
+				t.Errorf("GetRuleMetadata() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseRuleType(t *testing.T) {
+	for _, rt := range []RuleType{NotCategorizedRule, MustRule, ShouldRule, MayRule} {
+		got, err := ParseRuleType(RuleTypeString(rt))
+		if err != nil || got != rt {
+			t.Errorf("ParseRuleType(%q) got %v, %v, but want %v", RuleTypeString(rt), got, err, rt)
+		}
+	}
+	if _, err := ParseRuleType("often"); err == nil {
+		t.Error("ParseRuleType expects an error for an unknown type")
+	}
+}
@@ -31,13 +31,35 @@ const nameSeparator string = "::"
 var ruleNameValidator = regexp.MustCompile("^([a-z0-9][a-z0-9-]*(::[a-z0-9][a-z0-9-]*)?)+$")
 
 // NewRuleName creates a RuleName from an AIP number and a unique name within
-// that AIP.
+// that AIP. It panics if no group is registered for the AIP; see
+// RegisterGroup, and ParseRuleName to get an error instead.
 func NewRuleName(aip int, name string) RuleName {
+	return MustNewRuleName(aip, name)
+}
+
+// ParseRuleName creates a RuleName from an AIP number and a unique name
+// within that AIP, like NewRuleName, but returns an error if no group is
+// registered for the AIP.
+func ParseRuleName(aip int, name string) (RuleName, error) {
+	group, err := getRuleGroup(aip, aipGroups)
+	if err != nil {
+		return "", err
+	}
 	return RuleName(strings.Join([]string{
-		getRuleGroup(aip, aipGroups),
+		group,
 		fmt.Sprintf("%04d", aip),
 		name,
-	}, nameSeparator))
+	}, nameSeparator)), nil
+}
+
+// MustNewRuleName is like ParseRuleName, but panics if no group is
+// registered for the AIP.
+func MustNewRuleName(aip int, name string) RuleName {
+	r, err := ParseRuleName(aip, name)
+	if err != nil {
+		panic(err)
+	}
+	return r
 }
 
 // IsValid checks if a RuleName is syntactically valid.
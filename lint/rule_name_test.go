@@ -15,6 +15,7 @@
 package lint
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -87,6 +88,27 @@ func TestNewRuleName(t *testing.T) {
 	}
 }
 
+func TestParseRuleName(t *testing.T) {
+	if got, err := ParseRuleName(131, "http-method"); err != nil || got != "core::0131::http-method" {
+		t.Errorf("ParseRuleName got %q, %v, but want %q", got, err, "core::0131::http-method")
+	}
+	if _, err := ParseRuleName(-1, "http-method"); !errors.Is(err, errInvalidRuleGroup) {
+		t.Errorf("ParseRuleName got error %v for an AIP without group, but want %v", err, errInvalidRuleGroup)
+	}
+}
+
+func TestMustNewRuleName(t *testing.T) {
+	if got, want := MustNewRuleName(131, "http-method"), RuleName("core::0131::http-method"); got != want {
+		t.Errorf("Got %q, expected %q.", got, want)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustNewRuleName did not panic for an AIP without group")
+		}
+	}()
+	MustNewRuleName(-1, "http-method")
+}
+
 func TestRuleName_HasPrefix(t *testing.T) {
 	tests := []struct {
 		r         RuleName
@@ -29,9 +29,14 @@ var (
 )
 
 // Register registers the list of rules of the same AIP.
-// Return an error if any of the rules is found duplicate in the registry.
+// Return an error if no group is registered for the AIP, or if any of the
+// rules is found duplicate in the registry.
 func (r RuleRegistry) Register(aip int, rules ...ProtoRule) error {
-	rulePrefix := getRuleGroup(aip, aipGroups) + nameSeparator + fmt.Sprintf("%04d", aip)
+	group, err := getRuleGroup(aip, aipGroups)
+	if err != nil {
+		return err
+	}
+	rulePrefix := group + nameSeparator + fmt.Sprintf("%04d", aip)
 	for _, rl := range rules {
 		if !rl.GetName().IsValid() {
 			return errInvalidRuleName
@@ -50,6 +55,24 @@ func (r RuleRegistry) Register(aip int, rules ...ProtoRule) error {
 	return nil
 }
 
+// RegisterRule registers rules which do not belong to an AIP group, such as
+// the rules of plugins or custom rules declared in configs.
+// Return an error if any of the rules is found duplicate in the registry.
+func (r RuleRegistry) RegisterRule(rules ...ProtoRule) error {
+	for _, rl := range rules {
+		if !rl.GetName().IsValid() {
+			return errInvalidRuleName
+		}
+
+		if _, found := r[rl.GetName()]; found {
+			return errDuplicatedRuleName
+		}
+
+		r[rl.GetName()] = rl
+	}
+	return nil
+}
+
 // NewRuleRegistry creates a new rule registry.
 func NewRuleRegistry() RuleRegistry {
 	return make(RuleRegistry)
@@ -65,3 +65,26 @@ func TestRuleRegistryRegister(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleRegistryRegisterRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		ruleNames []RuleName
+		err       error
+	}{
+		{"Registered_Okay", []RuleName{"acme::0001::a", "custom::b"}, nil},
+		{"InvalidRuleName", []RuleName{"acme::Bad"}, errInvalidRuleName},
+		{"Duplicated", []RuleName{"custom::a", "custom::a"}, errDuplicatedRuleName},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rules := []ProtoRule{}
+			for _, name := range test.ruleNames {
+				rules = append(rules, &FileRule{Name: name})
+			}
+			if err := NewRuleRegistry().RegisterRule(rules...); err != test.err {
+				t.Errorf("RegisterRule(): got %v, but want %v", err, test.err)
+			}
+		})
+	}
+}
@@ -1,27 +1,43 @@
 package lint
 
-import "strings"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
 // A list of mapping functions, each of which returns the rule URL for
 // the given rule name, and if not found, return an empty string.
-//
-// At Google, we inject additional rule URL mappings into this list.
-// Example: google_rule_url_mappings.go
-// package lint
-//
-//	func init() {
-//	  ruleURLMappings = append(ruleURLMappings, internalRuleURLMapping)
-//	}
-//
-//	func internalRuleURLMapping(ruleName string) string {
-//	  ...
-//	}
+// NOTE: the list will be evaluated in the FILO order.
 var ruleURLMappings = []func(string) string{
 	coreRuleURL,
 	clientLibrariesRuleURL,
 	cloudRuleURL,
 }
 
+// RegisterRuleURLMapping registers a function returning the URL of the
+// documentation of the rules of a registered group, such as
+// "https://acme.example.com/lint/9001/field-names" for
+// "acme::9001::field-names". The function may return an empty string for
+// rules it does not document. Mappings registered later take precedence.
+//
+// Return an error if the group is not registered.
+func RegisterRuleURLMapping(group string, mapping func(ruleName string) string) error {
+	if mapping == nil {
+		return fmt.Errorf("rule URL mapping of group %q is nil", group)
+	}
+	if !slices.ContainsFunc(aipGroups, func(g ruleGroup) bool { return g.name == group }) {
+		return fmt.Errorf("%w: group %q is not registered", errInvalidRuleGroup, group)
+	}
+	ruleURLMappings = append(ruleURLMappings, func(ruleName string) string {
+		if !RuleName(ruleName).HasPrefix(group) {
+			return ""
+		}
+		return mapping(ruleName)
+	})
+	return nil
+}
+
 func coreRuleURL(ruleName string) string {
 	return groupURL(ruleName, "core")
 }
@@ -35,7 +51,7 @@ func cloudRuleURL(ruleName string) string {
 }
 
 func groupURL(ruleName, groupName string) string {
-	base := "https://linter.aip.dev/"
+	base := "https://linter.aep.dev/"
 	nameParts := strings.Split(ruleName, "::") // e.g., client-libraries::0122::camel-case-uris -> ["client-libraries", "0122", "camel-case-uris"]
 	if len(nameParts) == 0 || nameParts[0] != groupName {
 		return ""
@@ -44,6 +60,43 @@ func groupURL(ruleName, groupName string) string {
 	return base + path
 }
 
+// RuleURLFromTemplate returns the URL of the documentation of a rule from
+// a template such as "https://example.com/lint/{aep}/{name}", where:
+//
+//   - "{rule}" is the name of the rule, such as "core::0131::http-body";
+//   - "{group}" is its group, such as "core";
+//   - "{aep}" is its AEP number without leading zeros, such as "131";
+//   - "{name}" is the rest of its name, such as "http-body".
+func RuleURLFromTemplate(template string, rule RuleName) string {
+	parts := strings.Split(string(rule), nameSeparator)
+	aep, name := "", ""
+	if len(parts) > 1 {
+		aep = strings.TrimLeft(parts[1], "0")
+		name = strings.Join(parts[2:], "/")
+	}
+	return strings.NewReplacer(
+		"{rule}", string(rule),
+		"{group}", parts[0],
+		"{aep}", aep,
+		"{name}", name,
+	).Replace(template)
+}
+
+// RuleDocURL returns the URL of the documentation of a rule: the URL the
+// rule provides in its metadata, or else the URL from the template if not
+// empty, or else the URL from the rule URL mappings.
+func RuleDocURL(rule ProtoRule, template string) string {
+	if d, ok := rule.(DescribedRule); ok {
+		if url := d.GetMetadata().DocURL; url != "" {
+			return url
+		}
+	}
+	if template != "" {
+		return RuleURLFromTemplate(template, rule.GetName())
+	}
+	return getRuleURL(string(rule.GetName()), ruleURLMappings)
+}
+
 func getRuleURL(ruleName string, nameURLMappings []func(string) string) string {
 	for i := len(nameURLMappings) - 1; i >= 0; i-- {
 		if url := nameURLMappings[i](ruleName); url != "" {
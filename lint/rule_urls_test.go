@@ -10,7 +10,7 @@ func TestCoreRuleURL(t *testing.T) {
 		rule string
 		url  string
 	}{
-		{"CoreRule", "core::0122::camel-case-uris", "https://linter.aip.dev/122/camel-case-uris"},
+		{"CoreRule", "core::0122::camel-case-uris", "https://linter.aep.dev/122/camel-case-uris"},
 		{"NotCoreRule", "test::0122::camel-case-uris", ""},
 	}
 
@@ -29,7 +29,7 @@ func TestClientLibrariesRuleURL(t *testing.T) {
 		rule string
 		url  string
 	}{
-		{"ClientLibrariesRule", "client-libraries::4232::repeated-fields", "https://linter.aip.dev/4232/repeated-fields"},
+		{"ClientLibrariesRule", "client-libraries::4232::repeated-fields", "https://linter.aep.dev/4232/repeated-fields"},
 		{"NotClientLibrariesRule", "test::0122::camel-case-uris", ""},
 	}
 
@@ -48,7 +48,7 @@ func TestCloudRuleURL(t *testing.T) {
 		rule string
 		url  string
 	}{
-		{"CloudRule", "cloud::2500::generic-fields", "https://linter.aip.dev/2500/generic-fields"},
+		{"CloudRule", "cloud::2500::generic-fields", "https://linter.aep.dev/2500/generic-fields"},
 		{"NotCloudRule", "test::0122::camel-case-uris", ""},
 	}
 
@@ -94,3 +94,25 @@ func TestGetRuleURL(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleURLFromTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		rule     RuleName
+		url      string
+	}{
+		{"AEP", "https://aep.dev/{aep}", "core::0131::http-body", "https://aep.dev/131"},
+		{"Parts", "https://example.com/{group}/{aep}/{name}", "core::0131::http-body", "https://example.com/core/131/http-body"},
+		{"Rule", "https://example.com/lint?rule={rule}", "core::0131::http-body", "https://example.com/lint?rule=core::0131::http-body"},
+		{"NestedName", "https://example.com/{aep}/{name}", "core::0122::name-suffix::path", "https://example.com/122/name-suffix/path"},
+		{"NoAEP", "https://example.com/{group}/{name}", "acme", "https://example.com/acme/"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := RuleURLFromTemplate(test.template, test.rule); got != test.url {
+				t.Errorf("RuleURLFromTemplate(%q, %q) got %q, but want %q", test.template, test.rule, got, test.url)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/jhump/protoreflect/desc"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v2"
+)
+
+// SuppressionsFileName is the conventional name of a suppressions file.
+const SuppressionsFileName = ".api-linter-suppressions.yaml"
+
+// StaleSuppressionRuleName is the rule ID of problems reporting suppressions
+// which do not match any descriptor.
+const StaleSuppressionRuleName RuleName = "api-linter::stale-suppression"
+
+// Suppressions suppress problems on descriptors identified by their fully
+// qualified names, for protos that cannot carry disable comments (such as
+// vendored or generated ones).
+type Suppressions []Suppression
+
+// Suppression suppresses the problems of a rule on the descriptors whose
+// fully qualified names match a pattern, and on the descriptors within them.
+type Suppression struct {
+	// Rule is a rule name or prefix, as in a config.
+	Rule string `json:"rule" yaml:"rule"`
+	// Element is a pattern of fully qualified descriptor names, such as
+	// "acme.library.v1.Book.legacy_id". "*" matches any single name segment,
+	// and "**" matches any number of segments, as in "acme.internal.**".
+	// The package name identifies a file.
+	Element string `json:"element" yaml:"element"`
+	// Reason justifies the suppression.
+	Reason string `json:"reason" yaml:"reason"`
+
+	// The file the suppression was read from, and the one-based line and
+	// column of its entry, or zero if unknown.
+	path         string
+	line, column int
+}
+
+// FindSuppressionsFile returns the suppressions file that applies to the
+// file at the given path: the nearest one found by walking up from the
+// directory containing the file, as for config files. It returns an empty
+// string if there is none.
+func FindSuppressionsFile(path string) (string, error) {
+	files, err := findFilesUp(path, []string{SuppressionsFileName})
+	if err != nil || len(files) == 0 {
+		return "", err
+	}
+	return files[len(files)-1], nil
+}
+
+// ReadSuppressionsFromFile reads Suppressions from a file.
+// It supports JSON(.json) and YAML(.yaml or .yml) files.
+func ReadSuppressionsFromFile(path string) (Suppressions, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading Suppressions: %w", err)
+	}
+	var s Suppressions
+	switch filepath.Ext(path) {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&s); err != nil && err != io.EOF {
+			return nil, err
+		}
+	case ".yaml", ".yml":
+		if err := yaml.UnmarshalStrict(b, &s); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("reading Suppressions: unsupported format `%q` with file path `%q`", filepath.Ext(path), path)
+	}
+	positions := entryPositions(b, filepath.Ext(path))
+	if len(positions) != len(s) {
+		positions = nil
+	}
+	for i, sup := range s {
+		s[i].path = path
+		if positions != nil {
+			s[i].line, s[i].column = positions[i][0], positions[i][1]
+		}
+		if sup.Rule == "" || sup.Element == "" {
+			return nil, fmt.Errorf("reading Suppressions: suppression %d in %q must have a rule and an element", i, path)
+		}
+		if !doublestar.ValidatePattern(elementPath(sup.Element)) {
+			return nil, fmt.Errorf("reading Suppressions: suppression %d in %q has an invalid element pattern %q", i, path, sup.Element)
+		}
+	}
+	return s, nil
+}
+
+// Validate checks the suppressions against the given registry, and returns
+// an error for every rule name or prefix that does not match any registered
+// rule, as Configs.Validate does.
+func (s Suppressions) Validate(rules RuleRegistry) error {
+	var errs []error
+	for i, sup := range s {
+		if msg := unknownRuleMessage(sup.Rule, rules); msg != "" {
+			errs = append(errs, fmt.Errorf("suppression[%d].rule: %q %s", i, sup.Rule, msg))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// entryPositions returns the one-based line and column of the start of each
+// entry of the list in a suppressions file, or nil if they cannot be found.
+func entryPositions(b []byte, ext string) [][2]int {
+	var offsets []int
+	switch ext {
+	case ".json":
+		dec := json.NewDecoder(bytes.NewReader(b))
+		if t, err := dec.Token(); err != nil || t != json.Delim('[') {
+			return nil
+		}
+		for dec.More() {
+			off := int(dec.InputOffset())
+			for off < len(b) && strings.ContainsRune(" \t\r\n,", rune(b[off])) {
+				off++
+			}
+			offsets = append(offsets, off)
+			var entry json.RawMessage
+			if err := dec.Decode(&entry); err != nil {
+				return nil
+			}
+		}
+	default:
+		// Entries of a block sequence start with a dash, at the indentation
+		// of the first line of the document.
+		indent := -1
+		off := 0
+		for _, line := range strings.SplitAfter(string(b), "\n") {
+			content := strings.TrimLeft(line, " ")
+			trimmed := strings.TrimSpace(content)
+			switch {
+			case trimmed == "", strings.HasPrefix(trimmed, "#"), strings.HasPrefix(trimmed, "---"):
+			case indent < 0 && !strings.HasPrefix(content, "-"):
+				return nil
+			default:
+				if indent < 0 {
+					indent = len(line) - len(content)
+				}
+				if len(line)-len(content) == indent && strings.HasPrefix(content, "-") {
+					offsets = append(offsets, off+indent)
+				}
+			}
+			off += len(line)
+		}
+	}
+	positions := make([][2]int, len(offsets))
+	for i, off := range offsets {
+		lineStart := bytes.LastIndexByte(b[:off], '\n') + 1
+		positions[i] = [2]int{bytes.Count(b[:off], []byte("\n")) + 1, off - lineStart + 1}
+	}
+	return positions
+}
+
+// suppressing returns the index of the first suppression of the rule on the
+// descriptor or any of its parents, or -1 if there is none.
+func (s Suppressions) suppressing(rule ProtoRule, d desc.Descriptor) int {
+	// Must rules cannot be disabled.
+	if rule.GetRuleType() == MustRule {
+		return -1
+	}
+	ruleName := string(rule.GetName())
+	for ; d != nil; d = d.GetParent() {
+		for i, sup := range s {
+			if (matchRule(ruleName, sup.Rule) || matchRule(aliasMap[ruleName], sup.Rule)) && sup.matches(d) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// matches returns true if the suppression's element pattern matches the
+// descriptor.
+func (sup Suppression) matches(d desc.Descriptor) bool {
+	return matchElement(sup.Element, d)
+}
+
+// Unmatched returns the suppressions that do not match any descriptor in the
+// given files.
+func (s Suppressions) Unmatched(files ...*desc.FileDescriptor) Suppressions {
+	unmatched := Suppressions{}
+	for _, sup := range s {
+		if !sup.matchesAny(files) {
+			unmatched = append(unmatched, sup)
+		}
+	}
+	return unmatched
+}
+
+func (sup Suppression) matchesAny(files []*desc.FileDescriptor) bool {
+	for _, f := range files {
+		for _, d := range allDescriptors(f) {
+			if sup.matches(d) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// StaleProblems returns a problem for every suppression that does not match
+// any descriptor in the given files.
+func (s Suppressions) StaleProblems(files ...*desc.FileDescriptor) []Problem {
+	problems := []Problem{}
+	for _, sup := range s.Unmatched(files...) {
+		p := Problem{
+			Message: fmt.Sprintf("Suppression of %q on %q does not match any descriptor, and should be removed.", sup.Rule, sup.Element),
+			RuleID:  StaleSuppressionRuleName,
+			path:    sup.path,
+		}
+		if sup.line > 0 {
+			p.Location = &dpb.SourceCodeInfo_Location{
+				Span: []int32{int32(sup.line - 1), int32(sup.column - 1), int32(sup.column)},
+			}
+		}
+		problems = append(problems, p)
+	}
+	return problems
+}
+
+// matchElement returns true if the pattern of fully qualified names matches
+// the descriptor. A file is identified by its package name.
+func matchElement(pattern string, d desc.Descriptor) bool {
+	name := d.GetFullyQualifiedName()
+	if f, ok := d.(*desc.FileDescriptor); ok {
+		name = f.GetPackage()
+	}
+	matched, _ := doublestar.Match(elementPath(pattern), elementPath(name))
+	return matched
+}
+
+// elementPath converts a fully qualified name, or a pattern of them, into a
+// slash separated path that doublestar can match.
+func elementPath(name string) string {
+	return strings.ReplaceAll(strings.TrimPrefix(name, "."), ".", "/")
+}
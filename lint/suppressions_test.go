@@ -0,0 +1,238 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestReadSuppressionsFromFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"valid.yaml": `
+- rule: core::0131::http-body
+  element: acme.library.v1.Book.legacy_id
+  reason: Generated code.
+`,
+		"valid.json": `[{"rule": "core::0131", "element": "acme.**", "reason": "Vendored."}]`,
+		"lines.yaml": `---
+# Generated code.
+- rule: a
+  element: b
+
+-   rule: c
+    element: d
+`,
+		"lines.json":       "[\n  {\"rule\": \"a\", \"element\": \"b\"},\n  {\"rule\": \"c\", \"element\": \"d\"}\n]\n",
+		"unknown_key.yaml": "- rule: a\n  element: b\n  owner: c\n",
+		"missing.yaml":     "- rule: a\n",
+		"bad_pattern.yaml": "- rule: a\n  element: 'acme.[v1'\n",
+		"bad.txt":          "",
+	}
+	for name, content := range files {
+		if err := writeTestFile(filepath.Join(dir, name), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		file   string
+		want   Suppressions
+		hasErr bool
+	}{
+		{"valid.yaml", Suppressions{{Rule: "core::0131::http-body", Element: "acme.library.v1.Book.legacy_id", Reason: "Generated code.", line: 2, column: 1}}, false},
+		{"valid.json", Suppressions{{Rule: "core::0131", Element: "acme.**", Reason: "Vendored.", line: 1, column: 2}}, false},
+		{"lines.yaml", Suppressions{{Rule: "a", Element: "b", line: 3, column: 1}, {Rule: "c", Element: "d", line: 6, column: 1}}, false},
+		{"lines.json", Suppressions{{Rule: "a", Element: "b", line: 2, column: 3}, {Rule: "c", Element: "d", line: 3, column: 3}}, false},
+		{"unknown_key.yaml", nil, true},
+		{"missing.yaml", nil, true},
+		{"bad_pattern.yaml", nil, true},
+		{"bad.txt", nil, true},
+		{"not-existed.yaml", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			path := filepath.Join(dir, test.file)
+			got, err := ReadSuppressionsFromFile(path)
+			if (err != nil) != test.hasErr {
+				t.Fatalf("ReadSuppressionsFromFile got error %v, but want error %v", err, test.hasErr)
+			}
+			for i := range test.want {
+				test.want[i].path = path
+			}
+			if diff := cmp.Diff(test.want, got, cmp.AllowUnexported(Suppression{})); diff != "" {
+				t.Errorf("ReadSuppressionsFromFile mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLinter_UseSuppressions(t *testing.T) {
+	fd := parseTestProto(t, `syntax = "proto3";
+
+package acme.library.v1;
+
+message Book {
+  string name = 1;
+  string legacy_id = 2;
+}
+
+message Shelf {
+  string name = 1;
+}
+`)
+
+	rules := NewRuleRegistry()
+	err := rules.Register(111, &FieldRule{
+		Name: NewRuleName(111, "field-rule"),
+		LintField: func(f *desc.FieldDescriptor) []Problem {
+			return []Problem{{Message: "bad", Descriptor: f}}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		suppressions Suppressions
+		want         []string
+	}{
+		{
+			"None",
+			nil,
+			[]string{"acme.library.v1.Book.name", "acme.library.v1.Book.legacy_id", "acme.library.v1.Shelf.name"},
+		},
+		{
+			"Field",
+			Suppressions{{Rule: "core::0111::field-rule", Element: "acme.library.v1.Book.legacy_id"}},
+			[]string{"acme.library.v1.Book.name", "acme.library.v1.Shelf.name"},
+		},
+		{
+			"Parent",
+			Suppressions{{Rule: "core::0111", Element: "acme.library.v1.Book"}},
+			[]string{"acme.library.v1.Shelf.name"},
+		},
+		{
+			"Wildcard",
+			Suppressions{{Rule: "field-rule", Element: "acme.*.v1.*.name"}},
+			[]string{"acme.library.v1.Book.legacy_id"},
+		},
+		{
+			"Package",
+			Suppressions{{Rule: "all", Element: "acme.**"}},
+			nil,
+		},
+		{
+			"OtherRule",
+			Suppressions{{Rule: "core::0112", Element: "acme.**"}},
+			[]string{"acme.library.v1.Book.name", "acme.library.v1.Book.legacy_id", "acme.library.v1.Shelf.name"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := New(rules, nil, UseSuppressions(test.suppressions)).lintFileDescriptor(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, p := range resp.Problems {
+				got = append(got, p.Descriptor.GetFullyQualifiedName())
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Problems mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSuppressions_Unmatched(t *testing.T) {
+	fd := parseTestProto(t, `syntax = "proto3";
+
+package acme.library.v1;
+
+message Book {
+  string name = 1;
+}
+`)
+	s := Suppressions{
+		{Rule: "all", Element: "acme.library.v1.Book.name"},
+		{Rule: "all", Element: "acme.library.v1"},
+		{Rule: "all", Element: "acme.library.v1.Book.legacy_id", path: "suppressions.yaml", line: 7, column: 1},
+		{Rule: "all", Element: "acme.library.v2.**"},
+	}
+	want := Suppressions{s[2], s[3]}
+	if diff := cmp.Diff(want, s.Unmatched(fd), cmp.AllowUnexported(Suppression{})); diff != "" {
+		t.Errorf("Unmatched mismatch (-want +got):\n%s", diff)
+	}
+	problems := s.StaleProblems(fd)
+	if got := len(problems); got != 2 {
+		t.Fatalf("StaleProblems got %d problems, but want 2", got)
+	}
+	if got, want := problems[0].fileLocation().Path, "suppressions.yaml"; got != want {
+		t.Errorf("StaleProblems got path %q, but want %q", got, want)
+	}
+	if got, want := problems[0].Location.GetSpan(), []int32{6, 0, 1}; !cmp.Equal(got, want) {
+		t.Errorf("StaleProblems got span %v, but want %v", got, want)
+	}
+	if problems[1].Location != nil {
+		t.Errorf("StaleProblems got location %v for a suppression without a line, but want none", problems[1].Location)
+	}
+}
+
+func TestFindSuppressionsFile(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, ".git"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{SuppressionsFileName, filepath.Join("apis", "v1", SuppressionsFileName)} {
+		if err := writeTestFile(filepath.Join(root, name), "[]"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"a.proto", SuppressionsFileName},
+		{"apis/library.proto", SuppressionsFileName},
+		{"apis/v1/book.proto", filepath.Join("apis", "v1", SuppressionsFileName)},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			got, err := FindSuppressionsFile(filepath.Join(root, test.path))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := filepath.Join(root, test.want); got != want {
+				t.Errorf("FindSuppressionsFile got %q, but want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSuppressions_Validate(t *testing.T) {
+	registry := NewRuleRegistry()
+	if err := registry.Register(131, &FileRule{Name: NewRuleName(131, "http-body")}); err != nil {
+		t.Fatal(err)
+	}
+	valid := Suppressions{
+		{Rule: "core::0131::http-body", Element: "acme.**"},
+		{Rule: "core", Element: "acme.**"},
+		{Rule: "all", Element: "acme.**"},
+	}
+	if err := valid.Validate(registry); err != nil {
+		t.Errorf("Validate got error %v, but want none", err)
+	}
+	invalid := Suppressions{
+		{Rule: "core::0131::http-body", Element: "acme.**"},
+		{Rule: "core::0131::htp-body", Element: "acme.**"},
+	}
+	want := `suppression[1].rule: "core::0131::htp-body" does not match any rule; did you mean "core::0131::http-body"?`
+	if err := invalid.Validate(registry); err == nil || err.Error() != want {
+		t.Errorf("Validate got error %v, but want %q", err, want)
+	}
+}
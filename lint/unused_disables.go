@@ -0,0 +1,186 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// UnusedDisableRuleName is the rule ID of problems reporting disable comments
+// which did not suppress any problem, or which refer to rules that do not
+// exist. See the ReportUnusedDisables option.
+const UnusedDisableRuleName RuleName = "api-linter::unused-disable"
+
+// unusedDisables returns a problem for every disable directive in the file
+// that is not in the set of directives which suppressed a problem.
+func (l *Linter) unusedDisables(fd *desc.FileDescriptor, suppressing map[directiveKey]bool) []Problem {
+	var problems []Problem
+	for _, d := range allDescriptors(fd) {
+		for _, directive := range descriptorDirectives(d) {
+			// Expired directives and directives with an invalid expiry date
+			// are reported on their own, and enabled directives do not
+			// suppress problems.
+			if suppressing[directive.key()] || !directive.inEffect() || directive.enable {
+				continue
+			}
+			var msg string
+			if !matchesAnyRule(directive.rule, l.rules) {
+				msg = fmt.Sprintf("Disable comment refers to %q, which does not match any rule.", directive.rule)
+				if s := suggestRulePrefix(directive.rule, l.rules); s != "" {
+					msg += fmt.Sprintf(" Did you mean %q?", s)
+				}
+			} else {
+				msg = fmt.Sprintf("Disable comment for %q did not suppress any problem, and should be removed.", directive.rule)
+			}
+			p := Problem{
+				Message:    msg,
+				Descriptor: d,
+				RuleID:     UnusedDisableRuleName,
+			}
+			if loc, suggestion := directiveRemoval(directive); loc != nil {
+				p.Location = loc
+				p.Suggestion = suggestion
+			}
+			problems = append(problems, p)
+		}
+	}
+	return problems
+}
+
+// allDescriptors returns the file and every descriptor within it.
+func allDescriptors(fd *desc.FileDescriptor) []desc.Descriptor {
+	descriptors := []desc.Descriptor{fd}
+	for _, service := range fd.GetServices() {
+		descriptors = append(descriptors, service)
+		for _, method := range service.GetMethods() {
+			descriptors = append(descriptors, method)
+		}
+	}
+	for _, message := range GetAllMessages(fd) {
+		descriptors = append(descriptors, message)
+		for _, field := range message.GetFields() {
+			descriptors = append(descriptors, field)
+		}
+		for _, oneof := range message.GetOneOfs() {
+			descriptors = append(descriptors, oneof)
+		}
+		for _, ext := range message.GetNestedExtensions() {
+			descriptors = append(descriptors, ext)
+		}
+	}
+	for _, ext := range fd.GetExtensions() {
+		descriptors = append(descriptors, ext)
+	}
+	for _, enum := range getAllEnums(fd) {
+		descriptors = append(descriptors, enum)
+		for _, value := range enum.GetValues() {
+			descriptors = append(descriptors, value)
+		}
+	}
+	return descriptors
+}
+
+// directiveRemoval returns the location of the comment line containing the
+// directive, along with its replacement without the directive. If nothing
+// but the comment marker would be left, the line is removed.
+func directiveRemoval(directive disableDirective) (*dpb.SourceCodeInfo_Location, string) {
+	c, ok := findDirectiveComment(directive)
+	if !ok {
+		return nil, ""
+	}
+	remaining := removeDirective(c.text, directive.rule)
+	if remaining == "" {
+		return c.location(), ""
+	}
+	return c.location(), strings.Repeat(" ", int(c.indent)) + "//" + remaining + "\n"
+}
+
+// directiveComment is the line comment containing a directive.
+type directiveComment struct {
+	// The zero-based line of the comment.
+	line int32
+	// The indentation of the element the comment is attached to, which the
+	// comment is assumed to share.
+	indent int32
+	// The text of the comment after the comment marker.
+	text string
+}
+
+// location returns the location of the whole comment line, including its
+// line break, so that replacing it does not depend on its indentation.
+func (c directiveComment) location() *dpb.SourceCodeInfo_Location {
+	return &dpb.SourceCodeInfo_Location{Span: []int32{c.line, 0, c.line + 1, 0}}
+}
+
+// findDirectiveComment returns the comment line containing the directive in
+// the leading comments of its element.
+//
+// Leading comments are the comments right before the element, so a comment
+// made of line comments ends on the line before the element. Block comments,
+// whose lines cannot be told from the comment text, and trailing comments
+// are not looked up.
+func findDirectiveComment(directive disableDirective) (directiveComment, bool) {
+	if directive.trailing {
+		return directiveComment{}, false
+	}
+	var loc *dpb.SourceCodeInfo_Location
+	comments := ""
+	if f, ok := directive.descriptor.(*desc.FileDescriptor); ok {
+		loc = fileHeaderLocation(f)
+		// Detached comments are not adjacent to the location.
+		if len(loc.GetLeadingDetachedComments()) > 0 {
+			return directiveComment{}, false
+		}
+		comments = loc.GetLeadingComments()
+	} else {
+		loc = directive.descriptor.GetSourceInfo()
+		comments = loc.GetLeadingComments()
+	}
+	// Line comments end with a line break, unlike block comments.
+	if len(loc.GetSpan()) < 3 || !strings.HasSuffix(comments, "\n") {
+		return directiveComment{}, false
+	}
+	lines := strings.Split(strings.TrimSuffix(comments, "\n"), "\n")
+	if directive.line >= len(lines) {
+		return directiveComment{}, false
+	}
+	return directiveComment{
+		line:   loc.GetSpan()[0] - int32(len(lines)) + int32(directive.line),
+		indent: loc.GetSpan()[1],
+		text:   lines[directive.line],
+	}, true
+}
+
+var emptyDirectiveComment = regexp.MustCompile(`\(--\s*--\)`)
+
+// removeDirective returns the comment line without the rule in its
+// directive, and without the directive if it has no other rules.
+func removeDirective(commentLine, rule string) string {
+	start, clauses, end := parseDirectiveLine(commentLine)
+	if end < 0 {
+		return commentLine
+	}
+	var kept []string
+	for _, c := range clauses {
+		var rules []string
+		for _, r := range c.rules {
+			if r != rule {
+				rules = append(rules, r)
+			}
+		}
+		if len(rules) > 0 {
+			c.rules = rules
+			kept = append(kept, c.String())
+		}
+	}
+	directive := ""
+	if len(kept) > 0 {
+		directive = directivePrefix + " " + strings.Join(kept, " ")
+	}
+	commentLine = commentLine[:start] + directive + commentLine[end:]
+	commentLine = emptyDirectiveComment.ReplaceAllString(commentLine, "")
+	return strings.TrimRight(commentLine, " \t")
+}
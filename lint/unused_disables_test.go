@@ -0,0 +1,165 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+)
+
+func TestLinter_ReportUnusedDisables(t *testing.T) {
+	fd := parseTestProto(t, `// (-- api-linter: core::0111::message-name=disabled --)
+syntax = "proto3";
+
+package test;
+
+// A used disable comment.
+// (-- api-linter: core::0111::message-name=disabled --)
+message Bad {
+  // (-- api-linter: core::0111::message-name=disabled --)
+  string foo = 1;
+}
+
+message Good {
+  // (-- api-linter: core::0111::mesage-name=disabled --)
+  string bar = 1;
+}
+
+message LocatedBad {
+  // A disable comment used by a problem located on the field.
+  // (-- api-linter: core::0111::message-name=disabled --)
+  string baz = 1;
+}
+
+// Keep me. (-- api-linter: core::0111::message-name=disabled --)
+message Partial {}
+
+/* (-- api-linter: core::0111::message-name=disabled --) */
+message Block {}
+`)
+
+	rules := NewRuleRegistry()
+	err := rules.Register(111, &MessageRule{
+		Name: NewRuleName(111, "message-name"),
+		LintMessage: func(m *desc.MessageDescriptor) []Problem {
+			switch m.GetName() {
+			case "Bad":
+				return []Problem{{Message: "bad", Descriptor: m}}
+			case "LocatedBad":
+				return []Problem{{Message: "bad", Descriptor: m, Location: m.GetFields()[0].GetSourceInfo()}}
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type problem struct {
+		Message    string
+		Descriptor string
+		Span       []int32
+		Suggestion string
+	}
+	want := []problem{
+		{
+			Message:    `Disable comment for "core::0111::message-name" did not suppress any problem, and should be removed.`,
+			Descriptor: "test.proto",
+			Span:       []int32{0, 0, 1, 0},
+		},
+		{
+			Message:    `Disable comment for "core::0111::message-name" did not suppress any problem, and should be removed.`,
+			Descriptor: "test.Bad.foo",
+			Span:       []int32{8, 0, 9, 0},
+		},
+		{
+			Message:    `Disable comment refers to "core::0111::mesage-name", which does not match any rule. Did you mean "core::0111::message-name"?`,
+			Descriptor: "test.Good.bar",
+			Span:       []int32{13, 0, 14, 0},
+		},
+		{
+			Message:    `Disable comment for "core::0111::message-name" did not suppress any problem, and should be removed.`,
+			Descriptor: "test.Partial",
+			Span:       []int32{23, 0, 24, 0},
+			Suggestion: "// Keep me.\n",
+		},
+		{
+			// The lines of block comments are not located.
+			Message:    `Disable comment for "core::0111::message-name" did not suppress any problem, and should be removed.`,
+			Descriptor: "test.Block",
+		},
+	}
+
+	for _, report := range []bool{true, false} {
+		resp, err := New(rules, nil, ReportUnusedDisables(report)).lintFileDescriptor(fd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []problem
+		for _, p := range resp.Problems {
+			if p.RuleID != UnusedDisableRuleName {
+				t.Errorf("Got unexpected problem %q from %q", p.Message, p.RuleID)
+				continue
+			}
+			got = append(got, problem{p.Message, descriptorName(p.Descriptor), p.Location.GetSpan(), p.Suggestion})
+		}
+		if !report {
+			if len(got) != 0 {
+				t.Errorf("Got %d unused disable problems without ReportUnusedDisables, want none", len(got))
+			}
+			continue
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Problems mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	// Unused disables can be disabled by configs like rules.
+	configs := Configs{
+		{DisabledRules: []string{string(UnusedDisableRuleName)}},
+		{IncludedElements: []string{"test.Partial"}, EnabledRules: []string{string(UnusedDisableRuleName)}},
+	}
+	resp, err := New(rules, configs, ReportUnusedDisables(true)).lintFileDescriptor(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Problems) != 1 || descriptorName(resp.Problems[0].Descriptor) != "test.Partial" {
+		t.Errorf("Got problems %v with unused disables disabled by configs, want one on test.Partial", resp.Problems)
+	}
+}
+
+func TestRemoveDirective(t *testing.T) {
+	const rule = "core::0131::http-body"
+	tests := []struct {
+		line, want string
+	}{
+		{" (-- api-linter: core::0131::http-body=disabled --)", ""},
+		{" (-- api-linter: core::0131::http-body=disabled", " (--"},
+		{" Some text api-linter: core::0131::http-body=disabled", " Some text"},
+		{" (-- api-linter: core::0131::http-body,core::0132=disabled --)", " (-- api-linter: core::0132=disabled --)"},
+		{` (-- api-linter: core::0131::http-body=disabled core::0132=disabled reason="Legacy." --)`, ` (-- api-linter: core::0132=disabled reason="Legacy." --)`},
+		{" api-linter: disable-next-line core::0131::http-body, core::0132", " api-linter: disable-next-line core::0132"},
+		{" Unrelated comment.", " Unrelated comment."},
+	}
+	for _, test := range tests {
+		if got := removeDirective(test.line, rule); got != test.want {
+			t.Errorf("removeDirective(%q) got %q, but want %q", test.line, got, test.want)
+		}
+	}
+}
+
+// parseTestProto parses the given proto source as "test.proto", including
+// source code info.
+func parseTestProto(t *testing.T, source string) *desc.FileDescriptor {
+	t.Helper()
+	p := protoparse.Parser{
+		Accessor:              protoparse.FileContentsFromMap(map[string]string{"test.proto": source}),
+		IncludeSourceCodeInfo: true,
+	}
+	fds, err := p.ParseFiles("test.proto")
+	if err != nil {
+		t.Fatalf("Failed to parse test proto: %v", err)
+	}
+	return fds[0]
+}
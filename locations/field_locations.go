@@ -17,6 +17,8 @@ package locations
 import (
 	aepapi "buf.build/gen/go/aep/api/protocolbuffers/go/aep/api"
 	"github.com/jhump/protoreflect/desc"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
@@ -36,6 +38,27 @@ func FieldResourceReference(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Locatio
 	return pathLocation(f, 8, int(aepapi.E_FieldInfo.TypeDescriptor().Number()), 2, 0)
 }
 
+// FieldOptions returns the precise location of a field's options, including
+// the brackets around them.
+func FieldOptions(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Location {
+	return pathLocation(f, 8) // FieldDescriptor.options == 8
+}
+
+// FieldBehaviorValue returns the precise location of a field's behavior at
+// the given index, if any. The index counts the values of the
+// `google.api.field_behavior` annotation, then the ones of
+// `(aep.api.field_info).field_behavior`. If the latter is given in a message
+// literal, this is the location of the whole `aep.api.field_info`
+// annotation.
+func FieldBehaviorValue(f *desc.FieldDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	values, _ := proto.GetExtension(f.GetFieldOptions(), apb.E_FieldBehavior).([]apb.FieldBehavior)
+	if index < len(values) {
+		return pathLocation(f, 8, int(apb.E_FieldBehavior.TypeDescriptor().Number()), index) // FieldDescriptor.options == 8
+	}
+	// Path: FieldDescriptor.options (8) -> field_info extension number -> field_behavior field (3) -> array index
+	return nearestPathLocation(f, 2, 8, int(aepapi.E_FieldInfo.TypeDescriptor().Number()), 3, index-len(values))
+}
+
 // FieldType returns the precise location for a field's type.
 func FieldType(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Location {
 	if f.GetMessageType() != nil || f.GetEnumType() != nil {
@@ -86,3 +86,56 @@ func TestFieldResourceReference(t *testing.T) {
 	}
 }
 
+func TestFieldOptions(t *testing.T) {
+	f := parse(t, `
+		import "aep/api/field_info.proto";
+		message Book {
+		  string name = 1 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_IMMUTABLE];
+		  string title = 2;
+		}
+	`)
+	fields := f.GetMessageTypes()[0].GetFields()
+	if diff := cmp.Diff(FieldOptions(fields[0]).GetSpan(), []int32{4, 18, 82}); diff != "" {
+		t.Error(diff)
+	}
+	if loc := FieldOptions(fields[1]); loc != nil {
+		t.Errorf("FieldOptions got %v for a field without options, but want nil", loc)
+	}
+}
+
+func TestFieldBehaviorValue(t *testing.T) {
+	f := parse(t, `
+		import "aep/api/field_info.proto";
+		import "google/api/field_behavior.proto";
+		message Book {
+		  string name = 1 [
+		    (google.api.field_behavior) = IDENTIFIER,
+		    (aep.api.field_info).field_behavior = FIELD_BEHAVIOR_IMMUTABLE,
+		    (aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED
+		  ];
+		  string title = 2 [(aep.api.field_info) = {
+		    field_behavior: [FIELD_BEHAVIOR_REQUIRED]
+		  }];
+		}
+	`)
+	name, title := f.GetMessageTypes()[0].GetFields()[0], f.GetMessageTypes()[0].GetFields()[1]
+	for _, test := range []struct {
+		name  string
+		field *desc.FieldDescriptor
+		index int
+		span  []int32
+	}{
+		{"GoogleAPI", name, 0, []int32{6, 4, 44}},
+		{"FieldInfo", name, 1, []int32{7, 4, 66}},
+		{"SecondFieldInfo", name, 2, []int32{8, 4, 65}},
+		{"Missing", name, 3, nil},
+		// The fields of a message literal have no locations of their own.
+		{"Literal", title, 0, []int32{10, 20, 12, 3}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(FieldBehaviorValue(test.field, test.index).GetSpan(), test.span); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -37,6 +37,20 @@ func pathLocation(d desc.Descriptor, path ...int) *dpb.SourceCodeInfo_Location {
 	return sourceInfoRegistry.sourceInfo(d.GetFile()).findLocation(fullPath)
 }
 
+// nearestPathLocation returns the location for a given descriptor and path,
+// or else for the longest prefix of the path, of at least min elements, that
+// has a location. This locates the parts of option values given as message
+// literals, which have no locations of their own, unlike the ones given with
+// the full name of the option field.
+func nearestPathLocation(d desc.Descriptor, min int, path ...int) *dpb.SourceCodeInfo_Location {
+	for n := len(path); n >= min; n-- {
+		if loc := pathLocation(d, path[:n]...); loc != nil {
+			return loc
+		}
+	}
+	return nil
+}
+
 type sourceInfo map[string]*dpb.SourceCodeInfo_Location
 
 // findLocation returns the Location for a given path.
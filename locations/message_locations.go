@@ -25,3 +25,34 @@ import (
 func MessageResource(m *desc.MessageDescriptor) *dpb.SourceCodeInfo_Location {
 	return pathLocation(m, 7, int(aepapi.E_Resource.TypeDescriptor().Number())) // MessageDescriptor.options == 7
 }
+
+// ResourceType returns the precise location of the `type` of the message's
+// `aep.api.resource` annotation, if any.
+//
+// As for the other parts of the annotation, if the annotation is given as a
+// message literal, this is the location of the whole annotation.
+func ResourceType(m *desc.MessageDescriptor) *dpb.SourceCodeInfo_Location {
+	return resourceField(m, 1) // ResourceDescriptor.type == 1
+}
+
+// ResourcePattern returns the precise location of the `pattern` at the given
+// index of the message's `aep.api.resource` annotation, if any.
+func ResourcePattern(m *desc.MessageDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	return resourceField(m, 2, index) // ResourceDescriptor.pattern == 2
+}
+
+// ResourceSingular returns the precise location of the `singular` of the
+// message's `aep.api.resource` annotation, if any.
+func ResourceSingular(m *desc.MessageDescriptor) *dpb.SourceCodeInfo_Location {
+	return resourceField(m, 3) // ResourceDescriptor.singular == 3
+}
+
+// ResourcePlural returns the precise location of the `plural` of the
+// message's `aep.api.resource` annotation, if any.
+func ResourcePlural(m *desc.MessageDescriptor) *dpb.SourceCodeInfo_Location {
+	return resourceField(m, 4) // ResourceDescriptor.plural == 4
+}
+
+func resourceField(m *desc.MessageDescriptor, path ...int) *dpb.SourceCodeInfo_Location {
+	return nearestPathLocation(m, 2, append([]int{7, int(aepapi.E_Resource.TypeDescriptor().Number())}, path...)...) // MessageDescriptor.options == 7
+}
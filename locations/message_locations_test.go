@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestMessageResource(t *testing.T) {
@@ -35,3 +36,44 @@ func TestMessageResource(t *testing.T) {
 		t.Error(diff)
 	}
 }
+
+func TestResourceFields(t *testing.T) {
+	f := parse(t, `
+		import "aep/api/resource.proto";
+		message Book {
+		  option (aep.api.resource).type = "library.googleapis.com/Book";
+		  option (aep.api.resource).pattern = "publishers/{publisher}/books/{book}";
+		  option (aep.api.resource).pattern = "books/{book}";
+		  option (aep.api.resource).singular = "book";
+		  option (aep.api.resource).plural = "books";
+		}
+		message Shelf {
+		  option (aep.api.resource) = {
+		    type: "library.googleapis.com/Shelf"
+		    plural: "shelves"
+		  };
+		}
+		message Author {}
+	`)
+	book, shelf, author := f.GetMessageTypes()[0], f.GetMessageTypes()[1], f.GetMessageTypes()[2]
+	for _, test := range []struct {
+		name string
+		loc  *dpb.SourceCodeInfo_Location
+		span []int32
+	}{
+		{"Type", ResourceType(book), []int32{4, 2, 65}},
+		{"FirstPattern", ResourcePattern(book, 0), []int32{5, 2, 76}},
+		{"SecondPattern", ResourcePattern(book, 1), []int32{6, 2, 53}},
+		{"Singular", ResourceSingular(book), []int32{7, 2, 46}},
+		{"Plural", ResourcePlural(book), []int32{8, 2, 45}},
+		// The fields of a message literal have no locations of their own.
+		{"Literal", ResourcePlural(shelf), []int32{11, 2, 14, 4}},
+		{"NoAnnotation", ResourcePlural(author), nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.loc.GetSpan(), test.span); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -37,6 +37,27 @@ func MethodHTTPRule(m *desc.MethodDescriptor) *dpb.SourceCodeInfo_Location {
 	return MethodOption(m, int(apb.E_Http.TypeDescriptor().Number()))
 }
 
+// HTTPBody returns the precise location of the `body` of the method's
+// `google.api.http` rule, if any. If the rule is given as a message literal,
+// this is the location of the whole rule.
+func HTTPBody(m *desc.MethodDescriptor) *dpb.SourceCodeInfo_Location {
+	return nearestPathLocation(m, 2, 4, int(apb.E_Http.TypeDescriptor().Number()), 7) // MethodDescriptor.options == 4, HttpRule.body == 7
+}
+
+// HTTPPattern returns the precise location of the URI template of the
+// method's `google.api.http` rule, such as its `get` field, if any. If the
+// rule is given as a message literal, this is the location of the whole rule.
+func HTTPPattern(m *desc.MethodDescriptor) *dpb.SourceCodeInfo_Location {
+	// HttpRule.get == 2, put == 3, post == 4, delete == 5, patch == 6,
+	// custom == 8.
+	for _, pattern := range []int{2, 3, 4, 5, 6, 8} {
+		if loc := MethodOption(m, int(apb.E_Http.TypeDescriptor().Number()), pattern); loc != nil {
+			return loc
+		}
+	}
+	return MethodHTTPRule(m)
+}
+
 // MethodOperationInfo returns the precise location of the method's
 // `google.longrunning.operation_info` annotation, if any.
 func MethodOperationInfo(m *desc.MethodDescriptor) *dpb.SourceCodeInfo_Location {
@@ -50,6 +71,7 @@ func MethodSignature(m *desc.MethodDescriptor, index int) *dpb.SourceCodeInfo_Lo
 }
 
 // MethodOption returns the precise location of the method's option with the given field number, if any.
-func MethodOption(m *desc.MethodDescriptor, fieldNumber int) *dpb.SourceCodeInfo_Location {
-	return pathLocation(m, 4, fieldNumber) // MethodDescriptor.options == 4
+// Further field numbers and indexes locate a part of the option's value.
+func MethodOption(m *desc.MethodDescriptor, fieldNumber int, path ...int) *dpb.SourceCodeInfo_Location {
+	return pathLocation(m, append([]int{4, fieldNumber}, path...)...) // MethodDescriptor.options == 4
 }
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestMethodRequestType(t *testing.T) {
@@ -148,3 +149,43 @@ func TestMethodOption(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPBodyAndPattern(t *testing.T) {
+	f := parse(t, `
+		import "google/api/annotations.proto";
+		service Library {
+		  rpc UpdateBook(Book) returns (Book) {
+		    option (google.api.http).patch = "/v1/{name=publishers/*/books/*}";
+		    option (google.api.http).body = "book";
+		  }
+		  rpc CreateBook(Book) returns (Book) {
+		    option (google.api.http) = {
+		      post: "/v1/{parent=publishers/*}/books"
+		      body: "book"
+		    };
+		  }
+		  rpc GetBook(Book) returns (Book);
+		}
+		message Book {}
+	`)
+	update, create, get := f.GetServices()[0].GetMethods()[0], f.GetServices()[0].GetMethods()[1], f.GetServices()[0].GetMethods()[2]
+	for _, test := range []struct {
+		name string
+		loc  *dpb.SourceCodeInfo_Location
+		span []int32
+	}{
+		{"Body", HTTPBody(update), []int32{6, 4, 43}},
+		{"Pattern", HTTPPattern(update), []int32{5, 4, 71}},
+		// The fields of a message literal have no locations of their own.
+		{"LiteralBody", HTTPBody(create), []int32{9, 4, 12, 6}},
+		{"LiteralPattern", HTTPPattern(create), []int32{9, 4, 12, 6}},
+		{"NoRuleBody", HTTPBody(get), nil},
+		{"NoRulePattern", HTTPPattern(get), nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.loc.GetSpan(), test.span); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
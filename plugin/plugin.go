@@ -0,0 +1,299 @@
+// Package plugin runs rules provided by executables outside the linter,
+// so that rule sets can be versioned and shipped independently of it.
+//
+// A plugin is an executable speaking a JSON protocol:
+//
+//   - `<plugin> describe` prints a Description of the rules it provides.
+//   - `<plugin> lint <file>...` reads a serialized FileDescriptorSet from
+//     stdin, containing the files to lint and all of their dependencies,
+//     and prints a LintResponse with the problems found in the files.
+//
+// The plugin should exit with a non-zero status if it fails, and can
+// explain the failure on stderr.
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/aep-dev/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Description is printed by `<plugin> describe`.
+type Description struct {
+	Rules []RuleInfo `json:"rules"`
+}
+
+// RuleInfo describes a rule provided by a plugin.
+type RuleInfo struct {
+	// Name is the name of the rule, such as "acme::0001::field-names".
+	Name lint.RuleName `json:"name"`
+	// Type is "must", "should", "may", or empty if not categorized.
+	Type string `json:"type,omitempty"`
+	// Description is a short description of what the rule checks.
+	Description string `json:"description,omitempty"`
+	// Fixable is true if the rule suggests fixes for its problems.
+	Fixable bool `json:"fixable,omitempty"`
+	// DocURL is the URL of the rule documentation.
+	DocURL string `json:"doc_url,omitempty"`
+}
+
+// LintResponse is printed by `<plugin> lint`.
+type LintResponse struct {
+	Problems []Problem `json:"problems"`
+}
+
+// Problem is a problem found by a plugin rule.
+type Problem struct {
+	// File is the name of the file the problem is in.
+	File string `json:"file"`
+	// RuleID is the name of the rule which found the problem.
+	RuleID lint.RuleName `json:"rule_id"`
+	// Message describes the problem.
+	Message string `json:"message"`
+	// Suggestion is the suggested replacement of the problem's location,
+	// if any.
+	Suggestion string `json:"suggestion,omitempty"`
+	// Element is the fully qualified name of the element with the problem,
+	// or empty for the file itself.
+	Element string `json:"element,omitempty"`
+	// Span is the zero-based span of the problem, as in a
+	// SourceCodeInfo.Location. The span of the element is used if empty.
+	Span []int32 `json:"span,omitempty"`
+}
+
+// Plugin is a loaded plugin executable.
+type Plugin struct {
+	path  string
+	rules []RuleInfo
+
+	mu sync.Mutex
+	// The input of the last run of the plugin, and the problems it found by
+	// rule or its error. The linter runs every rule on a file before the
+	// next one, so only the last run is kept. The input identifies the
+	// file by its contents, since the same file can be parsed into several
+	// descriptors.
+	in       []byte
+	problems map[lint.RuleName][]Problem
+	err      error
+}
+
+// Load runs the plugin executable to learn the rules it provides.
+func Load(path string) (*Plugin, error) {
+	out, err := run(path, nil, "describe")
+	if err != nil {
+		return nil, err
+	}
+	var d Description
+	if err := json.Unmarshal(out, &d); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid description: %w", path, err)
+	}
+	for _, r := range d.Rules {
+		if !r.Name.IsValid() {
+			return nil, fmt.Errorf("plugin %s: invalid rule name %q", path, r.Name)
+		}
+		if _, err := lint.ParseRuleType(r.Type); err != nil {
+			return nil, fmt.Errorf("plugin %s: rule %q: %w", path, r.Name, err)
+		}
+	}
+	return &Plugin{
+		path:  path,
+		rules: d.Rules,
+	}, nil
+}
+
+// Rules returns the rules provided by the plugin.
+func (p *Plugin) Rules() []lint.ProtoRule {
+	rules := make([]lint.ProtoRule, 0, len(p.rules))
+	for _, r := range p.rules {
+		rt, _ := lint.ParseRuleType(r.Type)
+		rules = append(rules, &rule{info: r, ruleType: rt, plugin: p})
+	}
+	return rules
+}
+
+// Register adds the rules provided by the plugin to the registry.
+// Return an error if any of the rules is already registered.
+func (p *Plugin) Register(registry lint.RuleRegistry) error {
+	for _, r := range p.Rules() {
+		if err := registry.RegisterRule(r); err != nil {
+			return fmt.Errorf("plugin %s: rule %q: %w", p.path, r.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// lint returns the problems found by the rule in the file, running the
+// plugin the first time any of its rules lints the file.
+//
+// If the plugin fails, the error is returned to the first rule only, so that
+// it is reported once rather than for every rule of the plugin.
+func (p *Plugin) lint(name lint.RuleName, fd *desc.FileDescriptor) ([]lint.Problem, error) {
+	in, err := proto.MarshalOptions{Deterministic: true}.Marshal(desc.ToFileDescriptorSet(fd))
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", p.path, err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !bytes.Equal(p.in, in) {
+		p.in = in
+		p.problems, p.err = p.lintFile(fd, in)
+		if p.err != nil {
+			return nil, p.err
+		}
+	}
+	var problems []lint.Problem
+	for _, pp := range p.problems[name] {
+		// The problems were checked against the file when the plugin ran.
+		problem, _ := toLintProblem(fd, pp)
+		problems = append(problems, problem)
+	}
+	return problems, nil
+}
+
+// lintFile runs the plugin on the file, given as its serialized
+// FileDescriptorSet, and returns the problems it found by rule.
+func (p *Plugin) lintFile(fd *desc.FileDescriptor, in []byte) (map[lint.RuleName][]Problem, error) {
+	out, err := run(p.path, in, "lint", fd.GetName())
+	if err != nil {
+		return nil, err
+	}
+	var resp LintResponse
+	if err := json.Unmarshal(out, &resp); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid response: %w", p.path, err)
+	}
+
+	problems := map[lint.RuleName][]Problem{}
+	for _, r := range p.rules {
+		problems[r.Name] = nil
+	}
+	for _, pp := range resp.Problems {
+		if _, ok := problems[pp.RuleID]; !ok {
+			return nil, fmt.Errorf("plugin %s: problem from unknown rule %q", p.path, pp.RuleID)
+		}
+		if pp.File != fd.GetName() {
+			return nil, fmt.Errorf("plugin %s: problem in unexpected file %q", p.path, pp.File)
+		}
+		if _, err := toLintProblem(fd, pp); err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", p.path, err)
+		}
+		problems[pp.RuleID] = append(problems[pp.RuleID], pp)
+	}
+	return problems, nil
+}
+
+func toLintProblem(fd *desc.FileDescriptor, pp Problem) (lint.Problem, error) {
+	problem := lint.Problem{
+		Message:    pp.Message,
+		Suggestion: pp.Suggestion,
+		Descriptor: fd,
+	}
+	if pp.Element != "" {
+		d := fd.FindSymbol(strings.TrimPrefix(pp.Element, "."))
+		if d == nil {
+			return lint.Problem{}, fmt.Errorf("problem on unknown element %q", pp.Element)
+		}
+		problem.Descriptor = d
+	}
+	if len(pp.Span) > 0 {
+		if !validSpan(fd, pp.Span) {
+			return lint.Problem{}, fmt.Errorf("problem with invalid span %v", pp.Span)
+		}
+		problem.Location = &dpb.SourceCodeInfo_Location{Span: pp.Span}
+	}
+	return problem, nil
+}
+
+// validSpan returns true if the span has three or four values which are not
+// negative, ends after it starts, and ends within the file if its source
+// code info is known.
+func validSpan(fd *desc.FileDescriptor, span []int32) bool {
+	if len(span) != 3 && len(span) != 4 {
+		return false
+	}
+	for _, v := range span {
+		if v < 0 {
+			return false
+		}
+	}
+	endLine, endColumn := span[0], span[2]
+	if len(span) == 4 {
+		endLine, endColumn = span[2], span[3]
+	}
+	if endLine < span[0] || endLine == span[0] && endColumn < span[1] {
+		return false
+	}
+	// The location with an empty path spans the whole file.
+	for _, loc := range fd.AsFileDescriptorProto().GetSourceCodeInfo().GetLocation() {
+		if len(loc.GetPath()) > 0 {
+			continue
+		}
+		switch fileSpan := loc.GetSpan(); len(fileSpan) {
+		case 3:
+			return endLine <= fileSpan[0]
+		case 4:
+			return endLine <= fileSpan[2]
+		}
+	}
+	return true
+}
+
+// run runs the plugin with the given stdin and arguments, returning its
+// stdout.
+func run(path string, stdin []byte, args ...string) ([]byte, error) {
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("plugin %s %s: %w: %s", path, args[0], err, msg)
+		}
+		return nil, fmt.Errorf("plugin %s %s: %w", path, args[0], err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// rule is a rule provided by a plugin.
+type rule struct {
+	info     RuleInfo
+	ruleType lint.RuleType
+	plugin   *Plugin
+}
+
+func (r *rule) GetName() lint.RuleName {
+	return r.info.Name
+}
+
+func (r *rule) GetRuleType() lint.RuleType {
+	return r.ruleType
+}
+
+func (r *rule) GetMetadata() lint.RuleMetadata {
+	return lint.RuleMetadata{
+		Description: r.info.Description,
+		Fixable:     r.info.Fixable,
+		DocURL:      r.info.DocURL,
+	}
+}
+
+// Lint returns the problems found by the plugin, or none if it fails. The
+// linter calls LintWithError instead, to report the failure.
+func (r *rule) Lint(fd *desc.FileDescriptor) []lint.Problem {
+	problems, _ := r.LintWithError(fd)
+	return problems
+}
+
+// LintWithError returns the problems found by the plugin, or the error
+// running it.
+func (r *rule) LintWithError(fd *desc.FileDescriptor) ([]lint.Problem, error) {
+	return r.plugin.lint(r.info.Name, fd)
+}
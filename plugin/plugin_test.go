@@ -0,0 +1,215 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aep-dev/api-linter/lint"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// testPluginEnv makes the test binary act as a plugin, reporting a problem
+// on every message whose name contains "Bad".
+const testPluginEnv = "API_LINTER_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(testPluginEnv); mode != "" {
+		if err := runTestPlugin(mode, os.Args[len(os.Args)-1]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func runTestPlugin(mode, command string) error {
+	if mode == "fail" {
+		return fmt.Errorf("something went wrong")
+	}
+	if command == "describe" {
+		return json.NewEncoder(os.Stdout).Encode(Description{Rules: []RuleInfo{
+			{Name: "acme::0001::bad-messages", Type: "should", Description: "Messages must not be bad."},
+			{Name: "acme::0002::nothing"},
+		}})
+	}
+	in, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	var fds dpb.FileDescriptorSet
+	if err := proto.Unmarshal(in, &fds); err != nil {
+		return err
+	}
+	resp := LintResponse{Problems: []Problem{}}
+	f := fds.GetFile()[len(fds.GetFile())-1]
+	for _, m := range f.GetMessageType() {
+		if strings.Contains(m.GetName(), "Bad") {
+			resp.Problems = append(resp.Problems, Problem{
+				File:    f.GetName(),
+				RuleID:  "acme::0001::bad-messages",
+				Message: "Bad message.",
+				Element: f.GetPackage() + "." + m.GetName(),
+			})
+		}
+	}
+	switch mode {
+	case "unknown-rule":
+		resp.Problems = append(resp.Problems, Problem{File: f.GetName(), RuleID: "acme::0003::unknown"})
+	case "invalid-span":
+		resp.Problems = append(resp.Problems, Problem{File: f.GetName(), RuleID: "acme::0002::nothing", Span: []int32{-1, 0, 4}})
+	}
+	return json.NewEncoder(os.Stdout).Encode(resp)
+}
+
+func loadTestPlugin(t *testing.T, mode string) (*Plugin, error) {
+	t.Helper()
+	t.Setenv(testPluginEnv, mode)
+	return Load(os.Args[0])
+}
+
+func parseTestProto(t *testing.T) *desc.FileDescriptor {
+	t.Helper()
+	p := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"test.proto": `syntax = "proto3";
+package test;
+message Good {}
+message Bad {}
+// (-- api-linter: acme::0001::bad-messages=disabled --)
+message AlsoBad {}
+`,
+		}),
+		IncludeSourceCodeInfo: true,
+	}
+	fds, err := p.ParseFiles("test.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fds[0]
+}
+
+func TestPlugin(t *testing.T) {
+	p, err := loadTestPlugin(t, "ok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := lint.NewRuleRegistry()
+	if err := p.Register(registry); err != nil {
+		t.Fatal(err)
+	}
+	if got := registry["acme::0001::bad-messages"].GetRuleType(); got != lint.ShouldRule {
+		t.Errorf("GetRuleType got %v, but want %v", got, lint.ShouldRule)
+	}
+	if got := lint.GetRuleMetadata(registry["acme::0001::bad-messages"]).Description; got != "Messages must not be bad." {
+		t.Errorf("GetRuleMetadata got description %q, but want the plugin's", got)
+	}
+	if err := p.Register(registry); err == nil {
+		t.Error("Register expects an error for duplicate rules")
+	}
+
+	resp, err := lint.New(registry, nil).LintProtos(parseTestProto(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, problem := range resp[0].Problems {
+		got = append(got, fmt.Sprintf("%s %s", problem.RuleID, problem.Descriptor.GetFullyQualifiedName()))
+	}
+	want := []string{"acme::0001::bad-messages test.Bad"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Problems mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPlugin_Errors(t *testing.T) {
+	if _, err := loadTestPlugin(t, "fail"); err == nil || !strings.Contains(err.Error(), "something went wrong") {
+		t.Errorf("Load got error %v, but want the plugin's stderr", err)
+	}
+
+	p, err := loadTestPlugin(t, "unknown-rule")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := lint.NewRuleRegistry()
+	if err := p.Register(registry); err != nil {
+		t.Fatal(err)
+	}
+	// The plugin fails once for the file, and not for each of its rules.
+	_, err = lint.New(registry, nil).LintProtos(parseTestProto(t))
+	if err == nil || strings.Count(err.Error(), "unknown rule") != 1 {
+		t.Errorf("LintProtos got error %v, but want a single unknown rule error", err)
+	}
+	// The failure is returned as an error, rather than as a panic.
+	var ruleErr *lint.RuleError
+	if !errors.As(err, &ruleErr) || ruleErr.Panic != nil || ruleErr.Err == nil {
+		t.Errorf("LintProtos got error %#v, but want a *lint.RuleError with Err", err)
+	}
+
+	p, err = loadTestPlugin(t, "invalid-span")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry = lint.NewRuleRegistry()
+	if err := p.Register(registry); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lint.New(registry, nil).LintProtos(parseTestProto(t)); err == nil || !strings.Contains(err.Error(), "invalid span") {
+		t.Errorf("LintProtos got error %v, but want an invalid span error", err)
+	}
+}
+
+func TestPlugin_SameContents(t *testing.T) {
+	p, err := loadTestPlugin(t, "ok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := lint.NewRuleRegistry()
+	if err := p.Register(registry); err != nil {
+		t.Fatal(err)
+	}
+	// The problems found in a file are located in its own descriptors, even
+	// if the plugin already linted another descriptor of the same file.
+	l := lint.New(registry, nil)
+	for i := 0; i < 2; i++ {
+		fd := parseTestProto(t)
+		resp, err := l.LintProtos(fd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp[0].Problems) != 1 || resp[0].Problems[0].Descriptor != fd.FindMessage("test.Bad") {
+			t.Errorf("LintProtos got problems %v, but want one on the message of the linted file", resp[0].Problems)
+		}
+	}
+}
+
+func TestValidSpan(t *testing.T) {
+	fd := parseTestProto(t)
+	tests := []struct {
+		span []int32
+		want bool
+	}{
+		{[]int32{3, 0, 14}, true},
+		{[]int32{2, 0, 3, 14}, true},
+		{[]int32{3, 0}, false},
+		{[]int32{-1, 0, 14}, false},
+		{[]int32{3, 0, -1}, false},
+		{[]int32{3, 8, 4}, false},
+		{[]int32{3, 0, 2, 14}, false},
+		{[]int32{3, 0, 100, 1}, false},
+	}
+	for _, test := range tests {
+		if got := validSpan(fd, test.span); got != test.want {
+			t.Errorf("validSpan(%v) got %v, but want %v", test.span, got, test.want)
+		}
+	}
+}
@@ -20,8 +20,8 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
@@ -54,7 +54,7 @@ func isResourceMessage(m *desc.MessageDescriptor) bool {
 }
 
 func hasResourceAnnotation(m *desc.MessageDescriptor) bool {
-	return utils.GetResource(m) != nil
+	return descutil.GetResource(m) != nil
 }
 
 // getVariables returns a slice of variables declared in the pattern.
@@ -19,9 +19,9 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
@@ -46,7 +46,7 @@ func resourceDefsInFile(f *desc.FileDescriptor, defs map[string][]resourceDef) m
 }
 
 func resourceDefsInMsg(m *desc.MessageDescriptor, defs map[string][]resourceDef) {
-	if t := utils.GetResource(m).GetType(); t != "" {
+	if t := descutil.GetResource(m).GetType(); t != "" {
 		defs[t] = append(defs[t], resourceDef{m})
 	}
 	for _, m := range m.GetNestedMessageTypes() {
@@ -17,12 +17,12 @@ package aep0004
 import (
 	"testing"
 
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
 )
 
 func TestDuplicateResource(t *testing.T) {
-	f := testutils.ParseProto3Tmpls(t, map[string]string{
+	f := ruletest.ParseProto3Tmpls(t, map[string]string{
 		"dep.proto": `
 			import "aep/api/resource.proto";
 			package xyz;
@@ -46,7 +46,7 @@ func TestDuplicateResource(t *testing.T) {
 				}
 			}`,
 	}, nil)["test.proto"]
-	want := testutils.Problems{
+	want := ruletest.Problems{
 		lint.Problem{
 			Message:    "Multiple definitions for resource \"library.googleapis.com/Book\": message `abc.Book`, message `abc.Foo.Tome`.",
 			Descriptor: f.GetMessageTypes()[0],
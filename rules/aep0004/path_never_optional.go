@@ -15,25 +15,26 @@
 package aep0004
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var pathNeverOptional = &lint.MessageRule{
 	Name:     lint.NewRuleName(4, "path-never-optional"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(m *desc.MessageDescriptor) bool {
 		f := "path"
-		if nf := utils.GetResourceNameField(utils.GetResource(m)); nf != "" {
+		if nf := descutil.GetResourceNameField(descutil.GetResource(m)); nf != "" {
 			f = nf
 		}
-		return utils.IsResource(m) && m.FindFieldByName(f) != nil
+		return descutil.IsResource(m) && m.FindFieldByName(f) != nil
 	},
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		f := "path"
-		if nf := utils.GetResourceNameField(utils.GetResource(m)); nf != "" {
+		if nf := descutil.GetResourceNameField(descutil.GetResource(m)); nf != "" {
 			f = nf
 		}
 		field := m.FindFieldByName(f)
@@ -17,7 +17,7 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestPathNeverOptional(t *testing.T) {
@@ -25,13 +25,13 @@ func TestPathNeverOptional(t *testing.T) {
 		name      string
 		FieldPath string
 		Label     string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
-		{"Valid", "path", "", testutils.Problems{}},
-		{"InvalidProto3Optional", "path", "optional", testutils.Problems{{Message: "never be labeled"}}},
+		{"Valid", "path", "", ruletest.Problems{}},
+		{"InvalidProto3Optional", "path", "optional", ruletest.Problems{{Message: "never be labeled"}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				message Book {
 					option (aep.api.resource) = {
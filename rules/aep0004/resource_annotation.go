@@ -15,8 +15,8 @@
 package aep0004
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -25,7 +25,7 @@ var resourceAnnotation = &lint.MessageRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf:   isResourceMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		if utils.GetResource(m) == nil {
+		if descutil.GetResource(m) == nil {
 			return []lint.Problem{{
 				Message:    "Resource messages should include a `aep.api.resource` annotation.",
 				Descriptor: m,
@@ -17,13 +17,13 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceAnnotation(t *testing.T) {
 	// The rule should pass if the option is present on a resource message.
 	t.Run("Present", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
 			message Book {
 				option (aep.api.resource) = {
@@ -33,13 +33,13 @@ func TestResourceAnnotation(t *testing.T) {
 				string path = 1;
 			}
 		`)
-		if diff := (testutils.Problems{}).Diff(resourceAnnotation.Lint(f)); diff != "" {
+		if diff := (ruletest.Problems{}).Diff(resourceAnnotation.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
 	})
 
 	t.Run("SkipNested", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			message Foo {
 				message Bar {
 					string path = 1;
@@ -47,7 +47,7 @@ func TestResourceAnnotation(t *testing.T) {
 				Bar bar = 1;
 			}
 		`)
-		if diff := (testutils.Problems{}).Diff(resourceAnnotation.Lint(f)); diff != "" {
+		if diff := (ruletest.Problems{}).Diff(resourceAnnotation.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
 	})
@@ -58,15 +58,15 @@ func TestResourceAnnotation(t *testing.T) {
 		name        string
 		MessageName string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
-		{"ValidNoNameField", "Book", "title", testutils.Problems{}},
-		{"ValidRequestMessage", "GetBookRequest", "path", testutils.Problems{}},
-		{"ValidResponseMessage", "GetBookResponse", "path", testutils.Problems{}},
-		{"Invalid", "Book", "path", testutils.Problems{{Message: "aep.api.resource"}}},
+		{"ValidNoNameField", "Book", "title", ruletest.Problems{}},
+		{"ValidRequestMessage", "GetBookRequest", "path", ruletest.Problems{}},
+		{"ValidResponseMessage", "GetBookResponse", "path", ruletest.Problems{}},
+		{"Invalid", "Book", "path", ruletest.Problems{{Message: "aep.api.resource"}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					string {{.FieldName}} = 1;
 				}
@@ -19,9 +19,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -30,10 +30,10 @@ var identifierRegexp = regexp.MustCompile("^{[a-z][_a-z0-9-]*[a-z0-9]}$")
 var resourceNameComponentsAlternate = &lint.MessageRule{
 	Name:     lint.NewRuleName(4, "resource-name-components-alternate"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsResource,
+	OnlyIf:   descutil.IsResource,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		var problems []lint.Problem
-		resource := utils.GetResource(m)
+		resource := descutil.GetResource(m)
 		for _, p := range resource.GetPattern() {
 			components := strings.Split(p, "/")
 			for i, c := range components {
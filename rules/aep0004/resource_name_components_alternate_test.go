@@ -17,24 +17,24 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceNameComponentsAlternate(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		Pattern  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "author/{author}/books/{book}", testutils.Problems{}},
-		{"Valid", "publishers/{publisher}/books/{book}/editions/{book-edition}", testutils.Problems{}},
-		{"ValidSingleton", "user/{user}/config", testutils.Problems{}},
-		{"ValidWithIdSuffix", "stores/{store_id}/items/{item_id}", testutils.Problems{}},
-		{"InvalidDoubleCollection", "author/books/{book}", testutils.Problems{{Message: "must alternate"}}},
-		{"InvalidDoubleIdentifier", "books/{author}/{book}", testutils.Problems{{Message: "must alternate"}}},
+		{"Valid", "author/{author}/books/{book}", ruletest.Problems{}},
+		{"Valid", "publishers/{publisher}/books/{book}/editions/{book-edition}", ruletest.Problems{}},
+		{"ValidSingleton", "user/{user}/config", ruletest.Problems{}},
+		{"ValidWithIdSuffix", "stores/{store_id}/items/{item_id}", ruletest.Problems{}},
+		{"InvalidDoubleCollection", "author/books/{book}", ruletest.Problems{{Message: "must alternate"}}},
+		{"InvalidDoubleIdentifier", "books/{author}/{book}", ruletest.Problems{{Message: "must alternate"}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			message Book {
 				option (aep.api.resource) = {
@@ -15,21 +15,22 @@
 package aep0004
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var resourcePathField = &lint.MessageRule{
 	Name:     lint.NewRuleName(4, "resource-path-field"),
-	OnlyIf:   utils.IsResource,
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsResource,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		f := "path"
-		if nf := utils.GetResourceNameField(utils.GetResource(m)); nf != "" {
+		if nf := descutil.GetResourceNameField(descutil.GetResource(m)); nf != "" {
 			f = nf
 		}
 
-		return utils.LintFieldPresentAndSingularString(f)(m)
+		return descutil.LintFieldPresentAndSingularString(f)(m)
 	},
 }
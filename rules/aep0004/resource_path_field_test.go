@@ -18,7 +18,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,16 +27,16 @@ func TestResourceNameField(t *testing.T) {
 		name     string
 		Options  string
 		Field    string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"ValidBothPresent", `option (aep.api.resource) = { type: "foo" };`, `string path = 1;`, nil},
-		{"InvalidNoField", `option (aep.api.resource) = { type: "foo" };`, ``, testutils.Problems{{Message: "`path`"}}},
-		{"InvalidTypeNotString", `option (aep.api.resource) = { type: "foo" };`, `int32 path = 1;`, testutils.Problems{{Suggestion: "string"}}},
-		{"InvalidTypeRepeated", `option (aep.api.resource) = { type: "foo" };`, `repeated string path = 1;`, testutils.Problems{{Suggestion: "string"}}},
+		{"InvalidNoField", `option (aep.api.resource) = { type: "foo" };`, ``, ruletest.Problems{{Message: "`path`"}}},
+		{"InvalidTypeNotString", `option (aep.api.resource) = { type: "foo" };`, `int32 path = 1;`, ruletest.Problems{{Suggestion: "string"}}},
+		{"InvalidTypeRepeated", `option (aep.api.resource) = { type: "foo" };`, `repeated string path = 1;`, ruletest.Problems{{Suggestion: "string"}}},
 		{"IrrelevantNoAnnotation", ``, ``, nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				message Book {
 					{{.Options}}
@@ -19,9 +19,9 @@ import (
 	"strings"
 
 	aepapi "buf.build/gen/go/aep/api/protocolbuffers/go/aep/api"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
@@ -31,16 +31,21 @@ var resourcePattern = &lint.MessageRule{
 	OnlyIf:   hasResourceAnnotation,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		resource := utils.GetResource(m)
-		return lintResourcePattern(resource, m, locations.MessageResource(m))
+		resource := descutil.GetResource(m)
+		return lintResourcePattern(resource, m)
 	},
 }
 
-func lintResourcePattern(resource *aepapi.ResourceDescriptor, desc desc.Descriptor, loc *dpb.SourceCodeInfo_Location) []lint.Problem {
-	return lintResourcePatternCommon(resource.GetPattern(), desc, loc)
+func lintResourcePattern(resource *aepapi.ResourceDescriptor, m *desc.MessageDescriptor) []lint.Problem {
+	return lintResourcePatternCommon(resource.GetPattern(), m, locations.MessageResource(m), func(i int) *dpb.SourceCodeInfo_Location {
+		return locations.ResourcePattern(m, i)
+	})
 }
 
-func lintResourcePatternCommon(patterns []string, desc desc.Descriptor, loc *dpb.SourceCodeInfo_Location) []lint.Problem {
+// lintResourcePatternCommon lints the given patterns. Problems with no
+// pattern in particular are located at loc, and those with the i-th pattern
+// at patternLoc(i).
+func lintResourcePatternCommon(patterns []string, desc desc.Descriptor, loc *dpb.SourceCodeInfo_Location, patternLoc func(int) *dpb.SourceCodeInfo_Location) []lint.Problem {
 	// Are any patterns declared at all? If not, complain.
 	if len(patterns) == 0 {
 		return []lint.Problem{{
@@ -52,7 +57,7 @@ func lintResourcePatternCommon(patterns []string, desc desc.Descriptor, loc *dpb
 
 	// Ensure that the constant segments of the pattern uses camel case,
 	// not snake case, and there are no spaces.
-	for _, pattern := range patterns {
+	for i, pattern := range patterns {
 		plainPattern := getPlainPattern(pattern)
 
 		if strings.Contains(plainPattern, "_") {
@@ -62,14 +67,14 @@ func lintResourcePatternCommon(patterns []string, desc desc.Descriptor, loc *dpb
 					getDesiredPattern(pattern),
 				),
 				Descriptor: desc,
-				Location:   loc,
+				Location:   patternLoc(i),
 			}}
 		}
 		if strings.Contains(plainPattern, " ") {
 			return []lint.Problem{{
 				Message:    "Resource patterns should not have spaces",
 				Descriptor: desc,
-				Location:   loc,
+				Location:   patternLoc(i),
 			}}
 		}
 	}
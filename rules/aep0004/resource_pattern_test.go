@@ -17,27 +17,27 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourcePattern(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		Pattern  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", `pattern: "publishers/{publisher}/books/{book}"`, testutils.Problems{}},
-		{"ValidCamel", `pattern: "publishers/{publisher}/electronicBooks/{electronic_book}"`, testutils.Problems{}},
-		{"Missing", "", testutils.Problems{{Message: "declare resource name pattern"}}},
-		{"SnakeCase", `pattern: "book_publishers/{book_publisher}/books/{book}"`, testutils.Problems{{
+		{"Valid", `pattern: "publishers/{publisher}/books/{book}"`, ruletest.Problems{}},
+		{"ValidCamel", `pattern: "publishers/{publisher}/electronicBooks/{electronic_book}"`, ruletest.Problems{}},
+		{"Missing", "", ruletest.Problems{{Message: "declare resource name pattern"}}},
+		{"SnakeCase", `pattern: "book_publishers/{book_publisher}/books/{book}"`, ruletest.Problems{{
 			Message: "bookPublishers/{book_publisher}/books/{book}",
 		}}},
-		{"HasSpaces", `pattern: "publishers/{publisher}/ books /{book}"`, testutils.Problems{{
+		{"HasSpaces", `pattern: "publishers/{publisher}/ books /{book}"`, ruletest.Problems{{
 			Message: "Resource patterns should not have spaces",
 		}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 
 				message Book {
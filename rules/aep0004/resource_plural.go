@@ -17,9 +17,9 @@ package aep0004
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -28,15 +28,14 @@ var resourcePlural = &lint.MessageRule{
 	OnlyIf:   hasResourceAnnotation,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		r := utils.GetResource(m)
-		l := locations.MessageResource(m)
+		r := descutil.GetResource(m)
 		p := r.GetPlural()
-		pLower := utils.ToKebabCase(p)
+		pLower := descutil.ToKebabCase(p)
 		if p == "" {
 			return []lint.Problem{{
 				Message:    "Resources should declare plural.",
 				Descriptor: m,
-				Location:   l,
+				Location:   locations.MessageResource(m),
 			}}
 		}
 		if pLower != p {
@@ -45,7 +44,7 @@ var resourcePlural = &lint.MessageRule{
 					"Resource plural should be lowerCamelCase: %q", pLower,
 				),
 				Descriptor: m,
-				Location:   l,
+				Location:   locations.ResourcePlural(m),
 			}}
 		}
 		return nil
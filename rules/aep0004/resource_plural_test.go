@@ -16,14 +16,14 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourcePlural(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		Plural   string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{
 			"Valid",
@@ -33,27 +33,27 @@ func TestResourcePlural(t *testing.T) {
 		{
 			"InvalidMissing",
 			``,
-			testutils.Problems{{
+			ruletest.Problems{{
 				Message: "Resources should declare plural",
 			}},
 		},
 		{
 			"InvalidUpperCamel",
 			`plural: "BookShelves"`,
-			testutils.Problems{{
+			ruletest.Problems{{
 				Message: "Resource plural should be lowerCamelCase",
 			}},
 		},
 		{
 			"InvalidDash",
 			`plural: "Book-Shelves"`,
-			testutils.Problems{{
+			ruletest.Problems{{
 				Message: "Resource plural should be lowerCamelCase",
 			}},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			message Book {
 				option (aep.api.resource) = {
@@ -15,20 +15,21 @@
 package aep0004
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var resourceReferenceType = &lint.FieldRule{
 	Name:     lint.NewRuleName(4, "resource-reference-type"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.GetResourceReference(f) != nil
+		return descutil.GetResourceReference(f) != nil
 	},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
-		if utils.GetTypeName(f) != "string" {
+		if descutil.GetTypeName(f) != "string" {
 			// We assume that the likely mistake is probably that the annotation
 			// is wrong (and should not be there), and not that the type is wrong,
 			// because this is what we have observed in real life.
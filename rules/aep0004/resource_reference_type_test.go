@@ -17,7 +17,7 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceReferenceType(t *testing.T) {
@@ -26,15 +26,15 @@ func TestResourceReferenceType(t *testing.T) {
 		name       string
 		Type       string
 		Annotation string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", "string", annotation, nil},
 		{"ValidRepeated", "repeated string", annotation, nil},
-		{"Invalid", "Author", annotation, testutils.Problems{{Suggestion: ""}}},
+		{"Invalid", "Author", annotation, ruletest.Problems{{Suggestion: ""}}},
 		{"Irrelevant", "Author", "", nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 			  import "aep/api/resource.proto";
 			  import "aep/api/field_info.proto";
 				message Book {
@@ -17,9 +17,9 @@ package aep0004
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -28,16 +28,15 @@ var resourceSingular = &lint.MessageRule{
 	OnlyIf:   hasResourceAnnotation,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		r := utils.GetResource(m)
-		l := locations.MessageResource(m)
+		r := descutil.GetResource(m)
 		s := r.GetSingular()
-		_, typeName, ok := utils.SplitResourceTypeName(r.GetType())
-		lowerTypeName := utils.ToKebabCase(typeName)
+		_, typeName, ok := descutil.SplitResourceTypeName(r.GetType())
+		lowerTypeName := descutil.ToKebabCase(typeName)
 		if s == "" {
 			return []lint.Problem{{
 				Message:    fmt.Sprintf("Resources should declare singular: %q", lowerTypeName),
 				Descriptor: m,
-				Location:   l,
+				Location:   locations.MessageResource(m),
 			}}
 		}
 		if !ok || lowerTypeName != s {
@@ -47,7 +46,7 @@ var resourceSingular = &lint.MessageRule{
 					lowerTypeName,
 				),
 				Descriptor: m,
-				Location:   l,
+				Location:   locations.ResourceSingular(m),
 			}}
 		}
 		return nil
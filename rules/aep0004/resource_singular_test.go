@@ -16,14 +16,14 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceSingular(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		Singular string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{
 			"Valid",
@@ -33,20 +33,20 @@ func TestResourceSingular(t *testing.T) {
 		{
 			"InvalidDoesntMatchType",
 			`singular: "shelf"`,
-			testutils.Problems{{
+			ruletest.Problems{{
 				Message: "book",
 			}},
 		},
 		{
 			"InvalidMissing",
 			``,
-			testutils.Problems{{
+			ruletest.Problems{{
 				Message: "Resources should declare singular",
 			}},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			message Book {
 				option (aep.api.resource) = {
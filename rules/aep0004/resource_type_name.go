@@ -17,9 +17,9 @@ package aep0004
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,24 +27,24 @@ var resourceTypeName = &lint.MessageRule{
 	Name:     lint.NewRuleName(4, "resource-type-name"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(m *desc.MessageDescriptor) bool {
-		return utils.GetResource(m) != nil
+		return descutil.GetResource(m) != nil
 	},
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		resource := utils.GetResource(m)
-		_, typeName, ok := utils.SplitResourceTypeName(resource.GetType())
-		kebabCase := utils.ToKebabCase(typeName)
+		resource := descutil.GetResource(m)
+		_, typeName, ok := descutil.SplitResourceTypeName(resource.GetType())
+		kebabCase := descutil.ToKebabCase(typeName)
 		if !ok {
 			return []lint.Problem{{
 				Message:    "Resource type names must be of the form {Service Name}/{Type}.",
 				Descriptor: m,
-				Location:   locations.MessageResource(m),
+				Location:   locations.ResourceType(m),
 			}}
 		}
 		if kebabCase != typeName {
 			return []lint.Problem{{
 				Message:    fmt.Sprintf("Type must be kebob-case with alphanumeric characters: %q", kebabCase),
 				Descriptor: m,
-				Location:   locations.MessageResource(m),
+				Location:   locations.ResourceType(m),
 			}}
 		}
 		return nil
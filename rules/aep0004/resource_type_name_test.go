@@ -17,28 +17,28 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceTypeName(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		TypeName string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "library.googleapis.com/book", testutils.Problems{}},
-		{"InvalidTooMany", "library.googleapis.com/shelf/Book", testutils.Problems{{Message: "{Service Name}/{Type}"}}},
-		{"InvalidNotEnough", "library.googleapis.com~Book", testutils.Problems{{Message: "{Service Name}/{Type}"}}},
-		{"InvalidWithUnicode", "library.googleapis.com/BoØkLibre", testutils.Problems{{Message: `Type must be kebob-case`}}},
-		{"InvalidLowerCamelCase", "library.googleapis.com/bookLoan", testutils.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book-loan"`}}},
-		{"ValidLowerCamelCase", "library.googleapis.com/book-loan", testutils.Problems{}},
-		{"InvalidTypeNotAlphaNumeric", "library.googleapis.com/Book.:3", testutils.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book-:3"`}}},
-		{"InvalidTypeContainsEmoji", "library.googleapis.com/Book♥️", testutils.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book♥️"`}}},
-		{"InvalidTypeContainsDashes", "library.googleapis.com/Book-Shelf️", testutils.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book--she`}}},
-		{"InvalidTypeContainsUnderscore", "library.googleapis.com/Book_Shelf️", testutils.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book--she`}}},
+		{"Valid", "library.googleapis.com/book", ruletest.Problems{}},
+		{"InvalidTooMany", "library.googleapis.com/shelf/Book", ruletest.Problems{{Message: "{Service Name}/{Type}"}}},
+		{"InvalidNotEnough", "library.googleapis.com~Book", ruletest.Problems{{Message: "{Service Name}/{Type}"}}},
+		{"InvalidWithUnicode", "library.googleapis.com/BoØkLibre", ruletest.Problems{{Message: `Type must be kebob-case`}}},
+		{"InvalidLowerCamelCase", "library.googleapis.com/bookLoan", ruletest.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book-loan"`}}},
+		{"ValidLowerCamelCase", "library.googleapis.com/book-loan", ruletest.Problems{}},
+		{"InvalidTypeNotAlphaNumeric", "library.googleapis.com/Book.:3", ruletest.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book-:3"`}}},
+		{"InvalidTypeContainsEmoji", "library.googleapis.com/Book♥️", ruletest.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book♥️"`}}},
+		{"InvalidTypeContainsDashes", "library.googleapis.com/Book-Shelf️", ruletest.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book--she`}}},
+		{"InvalidTypeContainsUnderscore", "library.googleapis.com/Book_Shelf️", ruletest.Problems{{Message: `Type must be kebob-case with alphanumeric characters: "book--she`}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			message Book {
 				option (aep.api.resource) = {
@@ -19,9 +19,9 @@ import (
 	"strings"
 
 	aepapi "buf.build/gen/go/aep/api/protocolbuffers/go/aep/api"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	apb "google.golang.org/genproto/googleapis/api/annotations"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
@@ -32,26 +32,32 @@ var resourceVariables = &lint.MessageRule{
 	OnlyIf:   hasResourceAnnotation,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		resource := utils.GetResource(m)
+		resource := descutil.GetResource(m)
 
-		return lintResourceVariables(resource, m, locations.MessageResource(m))
+		return lintResourceVariables(resource, m)
 	},
 }
 
 // lintResourceVariables lints the resource ID segments of the pattern(s) in the
 // give ResourceDescriptor. This is used for the message-level annotation
 // aep.api.resource.
-func lintResourceVariables(resource *aepapi.ResourceDescriptor, desc desc.Descriptor, loc *dpb.SourceCodeInfo_Location) []lint.Problem {
-	return lintResourceVariablesCommon(resource.GetPattern(), desc, loc)
+func lintResourceVariables(resource *aepapi.ResourceDescriptor, m *desc.MessageDescriptor) []lint.Problem {
+	return lintResourceVariablesCommon(resource.GetPattern(), m, func(i int) *dpb.SourceCodeInfo_Location {
+		return locations.ResourcePattern(m, i)
+	})
 }
 
 // lintResourceVariablesGoogleAPI lints resource variables for Google API ResourceDescriptor (used for file-level resource definitions)
 func lintResourceVariablesGoogleAPI(resource *apb.ResourceDescriptor, desc desc.Descriptor, loc *dpb.SourceCodeInfo_Location) []lint.Problem {
-	return lintResourceVariablesCommon(resource.GetPattern(), desc, loc)
+	return lintResourceVariablesCommon(resource.GetPattern(), desc, func(int) *dpb.SourceCodeInfo_Location {
+		return loc
+	})
 }
 
-func lintResourceVariablesCommon(patterns []string, desc desc.Descriptor, loc *dpb.SourceCodeInfo_Location) []lint.Problem {
-	for _, pattern := range patterns {
+// lintResourceVariablesCommon lints the variables of the given patterns,
+// locating the problems with the i-th pattern at patternLoc(i).
+func lintResourceVariablesCommon(patterns []string, desc desc.Descriptor, patternLoc func(int) *dpb.SourceCodeInfo_Location) []lint.Problem {
+	for i, pattern := range patterns {
 		for _, variable := range getVariables(pattern) {
 			if strings.ToLower(variable) != variable {
 				return []lint.Problem{{
@@ -60,7 +66,7 @@ func lintResourceVariablesCommon(patterns []string, desc desc.Descriptor, loc *d
 						getDesiredPattern(pattern),
 					),
 					Descriptor: desc,
-					Location:   loc,
+					Location:   patternLoc(i),
 				}}
 			}
 		}
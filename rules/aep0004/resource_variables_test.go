@@ -17,23 +17,23 @@ package aep0004
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceVariables(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		Pattern  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "publishers/{publisher}/electronicBooks/{electronic_book}", testutils.Problems{}},
-		{"ValidWithIdSuffix", "publishers/{publisher_id}/electronicBooks/{electronic_book_id}", testutils.Problems{}},
-		{"CamelCase", "publishers/{publisher}/electronicBooks/{electronicBook}", testutils.Problems{{
+		{"Valid", "publishers/{publisher}/electronicBooks/{electronic_book}", ruletest.Problems{}},
+		{"ValidWithIdSuffix", "publishers/{publisher_id}/electronicBooks/{electronic_book_id}", ruletest.Problems{}},
+		{"CamelCase", "publishers/{publisher}/electronicBooks/{electronicBook}", ruletest.Problems{{
 			Message: "publishers/{publisher}/electronicBooks/{electronic_book}",
 		}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				message Book {
 					option (aep.api.resource) = {
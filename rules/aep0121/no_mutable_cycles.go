@@ -18,17 +18,17 @@ import (
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var noMutableCycles = &lint.MessageRule{
 	Name:   lint.NewRuleName(121, "no-mutable-cycles"),
-	OnlyIf: utils.IsResource,
+	OnlyIf: descutil.IsResource,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		res := utils.GetResource(m)
+		res := descutil.GetResource(m)
 
 		return findCycles(res.GetType(), m, stringset.New(), nil)
 	},
@@ -36,7 +36,7 @@ var noMutableCycles = &lint.MessageRule{
 
 func findCycles(start string, node *desc.MessageDescriptor, seen stringset.Set, chain []string) []lint.Problem {
 	var problems []lint.Problem
-	nodeRes := utils.GetResource(node)
+	nodeRes := descutil.GetResource(node)
 
 	chain = append(chain, nodeRes.GetType())
 	seen.Add(nodeRes.GetType())
@@ -45,7 +45,7 @@ func findCycles(start string, node *desc.MessageDescriptor, seen stringset.Set,
 		if !isMutableReference(f) {
 			continue
 		}
-		ref := utils.GetResourceReference(f)
+		ref := descutil.GetResourceReference(f)
 		// Skip indirect references for now.
 		if len(ref.GetChildType()) > 0 {
 			continue
@@ -63,7 +63,7 @@ func findCycles(start string, node *desc.MessageDescriptor, seen stringset.Set,
 				Location:   locations.FieldResourceReference(f),
 			})
 		} else if !seen.Contains(refType) {
-			next := utils.FindResourceMessage(refType, node.GetFile())
+			next := descutil.FindResourceMessage(refType, node.GetFile())
 			// Skip unresolvable references.
 			if next == nil {
 				continue
@@ -90,6 +90,6 @@ func findCycles(start string, node *desc.MessageDescriptor, seen stringset.Set,
 }
 
 func isMutableReference(f *desc.FieldDescriptor) bool {
-	behaviors := utils.GetFieldBehavior(f)
-	return utils.HasResourceReference(f) && !behaviors.Contains("OUTPUT_ONLY")
+	behaviors := descutil.GetFieldBehavior(f)
+	return descutil.HasResourceReference(f) && !behaviors.Contains("OUTPUT_ONLY")
 }
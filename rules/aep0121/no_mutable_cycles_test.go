@@ -17,7 +17,7 @@ package aep0121
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestNoMutableCycles(t *testing.T) {
@@ -25,7 +25,7 @@ func TestNoMutableCycles(t *testing.T) {
 	for _, test := range []struct {
 		name                                                                             string
 		BookExtensions, PublisherExtensions, LibraryExtensions, OtherPublisherExtensions string
-		problems                                                                         testutils.Problems
+		problems                                                                         ruletest.Problems
 	}{
 		{
 			"ValidNoCycle",
@@ -41,7 +41,7 @@ func TestNoMutableCycles(t *testing.T) {
 			`[(aep.api.field_info).resource_reference = "library.googleapis.com/Book"]`,
 			"",
 			"",
-			testutils.Problems{{
+			ruletest.Problems{{
 				Message: "cycle",
 			}},
 		},
@@ -51,7 +51,7 @@ func TestNoMutableCycles(t *testing.T) {
 			`[(aep.api.field_info).resource_reference = "library.googleapis.com/Publisher"]`,
 			"",
 			"",
-			testutils.Problems{{
+			ruletest.Problems{{
 				Message: "cycle",
 			}},
 		},
@@ -61,7 +61,7 @@ func TestNoMutableCycles(t *testing.T) {
 			`[(aep.api.field_info).resource_reference = "library.googleapis.com/Library"]`,
 			`[(aep.api.field_info).resource_reference = "library.googleapis.com/Book"]`,
 			"",
-			testutils.Problems{{
+			ruletest.Problems{{
 				Message: "cycle",
 			}},
 		},
@@ -71,7 +71,7 @@ func TestNoMutableCycles(t *testing.T) {
 			`[(aep.api.field_info).resource_reference = "library.googleapis.com/Library"]`,
 			`[(aep.api.field_info).resource_reference = "library.googleapis.com/Book"]`,
 			`[(aep.api.field_info).resource_reference = "library.googleapis.com/Book"]`,
-			testutils.Problems{
+			ruletest.Problems{
 				{
 					Message: "cycle",
 				},
@@ -104,7 +104,7 @@ func TestNoMutableCycles(t *testing.T) {
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			import "aep/api/field_info.proto";
 			message Book {
@@ -18,8 +18,8 @@ import (
 	"fmt"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -35,21 +35,21 @@ var resourceMustSupportGet = &lint.ServiceRule{
 		for _, m := range s.GetMethods() {
 			// Streaming methods do not count as standard methods even if they
 			// look like them.
-			if utils.IsStreaming(m) {
+			if descutil.IsStreaming(m) {
 				continue
 			}
 
-			if utils.IsGetMethod(m) && utils.IsResource(utils.GetResponseType(m)) {
-				t := utils.GetResource(m.GetOutputType()).GetType()
+			if descutil.IsGetMethod(m) && descutil.IsResource(descutil.GetResponseType(m)) {
+				t := descutil.GetResource(m.GetOutputType()).GetType()
 				resourcesWithGet.Add(t)
-			} else if utils.IsCreateMethod(m) || utils.IsUpdateMethod(m) {
-				if msg := utils.GetResponseType(m); msg != nil && utils.IsResource(msg) {
-					t := utils.GetResource(msg).GetType()
+			} else if descutil.IsCreateMethod(m) || descutil.IsUpdateMethod(m) {
+				if msg := descutil.GetResponseType(m); msg != nil && descutil.IsResource(msg) {
+					t := descutil.GetResource(msg).GetType()
 					resourcesWithOtherMethods.Add(t)
 				}
-			} else if utils.IsListMethod(m) {
-				if msg := utils.GetListResourceMessage(m); msg != nil && utils.IsResource(msg) {
-					t := utils.GetResource(msg).GetType()
+			} else if descutil.IsListMethod(m) {
+				if msg := descutil.GetListResourceMessage(m); msg != nil && descutil.IsResource(msg) {
+					t := descutil.GetResource(msg).GetType()
 					resourcesWithOtherMethods.Add(t)
 				}
 			}
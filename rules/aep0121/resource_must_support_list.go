@@ -18,8 +18,8 @@ import (
 	"fmt"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -35,22 +35,22 @@ var resourceMustSupportList = &lint.ServiceRule{
 		for _, m := range s.GetMethods() {
 			// Streaming methods do not count as standard methods even if they
 			// look like them.
-			if utils.IsStreaming(m) {
+			if descutil.IsStreaming(m) {
 				continue
 			}
 
-			if utils.IsListMethod(m) {
-				if msg := utils.GetListResourceMessage(m); msg != nil && utils.IsResource(msg) {
-					t := utils.GetResource(msg).GetType()
+			if descutil.IsListMethod(m) {
+				if msg := descutil.GetListResourceMessage(m); msg != nil && descutil.IsResource(msg) {
+					t := descutil.GetResource(msg).GetType()
 					resourcesWithList.Add(t)
 				}
-			} else if utils.IsCreateMethod(m) || utils.IsUpdateMethod(m) || utils.IsGetMethod(m) {
-				if msg := utils.GetResponseType(m); msg != nil && utils.IsResource(msg) {
+			} else if descutil.IsCreateMethod(m) || descutil.IsUpdateMethod(m) || descutil.IsGetMethod(m) {
+				if msg := descutil.GetResponseType(m); msg != nil && descutil.IsResource(msg) {
 					// Skip tracking Singleton resources, they do not need List.
-					if utils.IsSingletonResource(msg) {
+					if descutil.IsSingletonResource(msg) {
 						continue
 					}
-					t := utils.GetResource(msg).GetType()
+					t := descutil.GetResource(msg).GetType()
 					resourcesWithOtherMethods.Add(t)
 				}
 			}
@@ -17,8 +17,8 @@ package aep0121
 import (
 	"testing"
 
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
 )
 
 // TestResourceMustSupportList tests the resourceMustSupportList
@@ -29,7 +29,7 @@ func TestResourceMustSupportList(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		RPCs     string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"ValidCreateList", `
 			rpc ListBooks(ListBooksRequest) returns (ListBooksResponse) {};
@@ -86,7 +86,7 @@ func TestResourceMustSupportList(t *testing.T) {
 		`, nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/longrunning/operations.proto";
 				import "google/protobuf/field_mask.proto";
@@ -18,9 +18,9 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -29,7 +29,7 @@ var httpURICase = &lint.MethodRule{
 	Name: lint.NewRuleName(122, "kebab-case-uris"),
 	LintMethod: func(m *desc.MethodDescriptor) (problems []lint.Problem) {
 		// Establish that the URI does not include a `_` character.
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for _, httpRule := range descutil.GetHTTPRules(m) {
 			if HasUpper(httpRule.GetPlainURI()) {
 				problems = append(problems, lint.Problem{
 					Message:    "HTTP URI patterns should use kebab-case, not camelCase.",
@@ -17,27 +17,27 @@ package aep0122
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpUriField(t *testing.T) {
 	tests := []struct {
 		testName string
 		URI      string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "/v1/{name=publishers/*/books/*}:frob", testutils.Problems{}},
-		{"InvalidCamelPattern", "/v1/{name=publishers/*/frobbableBooks/*}:frob", testutils.Problems{{Message: "HTTP URI patterns"}}},
-		{"InvalidSnakePattern", "/v1/{name=publishers/*/frobbable_books/*}:frob", testutils.Problems{{Message: "URI patterns"}}},
-		{"InvalidCamelVariable", "/v1/{bookName=publishers/*/books/*}:frob", testutils.Problems{{Message: "Variable names"}}},
-		{"ValidSnakeVariable", "/v1/{book_name=publishers/*/books/*}:frob", testutils.Problems{}},
-		{"ValidSnakeSoloVariable", "/v1/{book_name}:frob", testutils.Problems{}},
-		{"InvalidCamelSoloVariable", "/v1/{bookName}:frob", testutils.Problems{{Message: "Variable names"}}},
-		{"ValidVersionTemplateVariable", "/{$api_version}/{book_name}:frob", testutils.Problems{}},
+		{"Valid", "/v1/{name=publishers/*/books/*}:frob", ruletest.Problems{}},
+		{"InvalidCamelPattern", "/v1/{name=publishers/*/frobbableBooks/*}:frob", ruletest.Problems{{Message: "HTTP URI patterns"}}},
+		{"InvalidSnakePattern", "/v1/{name=publishers/*/frobbable_books/*}:frob", ruletest.Problems{{Message: "URI patterns"}}},
+		{"InvalidCamelVariable", "/v1/{bookName=publishers/*/books/*}:frob", ruletest.Problems{{Message: "Variable names"}}},
+		{"ValidSnakeVariable", "/v1/{book_name=publishers/*/books/*}:frob", ruletest.Problems{}},
+		{"ValidSnakeSoloVariable", "/v1/{book_name}:frob", ruletest.Problems{}},
+		{"InvalidCamelSoloVariable", "/v1/{bookName}:frob", ruletest.Problems{{Message: "Variable names"}}},
+		{"ValidVersionTemplateVariable", "/{$api_version}/{book_name}:frob", ruletest.Problems{}},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc FrobBook(FrobBookRequest) returns (FrobBookResponse) {
@@ -15,15 +15,15 @@
 package aep0122
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var noSelfLinks = &lint.MessageRule{
 	Name:   lint.NewRuleName(122, "no-self-links"),
-	OnlyIf: utils.IsResource,
+	OnlyIf: descutil.IsResource,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		problems := []lint.Problem{}
 		for _, field := range m.GetFields() {
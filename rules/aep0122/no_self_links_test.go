@@ -17,20 +17,20 @@ package aep0122
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestNoSelfLinks(t *testing.T) {
 	for _, test := range []struct {
 		name      string
 		FieldName string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
 		{"Valid", "author", nil},
-		{"InvalidSelfLink", "self_link", testutils.Problems{{Message: "self-links"}}},
+		{"InvalidSelfLink", "self_link", ruletest.Problems{{Message: "self-links"}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			message Book {
 				option (aep.api.resource) = {
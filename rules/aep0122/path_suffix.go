@@ -23,7 +23,8 @@ import (
 )
 
 var pathSuffix = &lint.FieldRule{
-	Name: lint.NewRuleName(122, "path-suffix"),
+	Name:     lint.NewRuleName(122, "path-suffix"),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
 		return strings.HasSuffix(f.GetName(), "_path")
 	},
@@ -17,24 +17,24 @@ package aep0122
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestPathSuffix(t *testing.T) {
 	for _, test := range []struct {
 		name      string
 		FieldName string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
-		{"Valid", "publisher", testutils.Problems{}},
-		{"ValidStandardDisplay", "display_name", testutils.Problems{}},
-		{"ValidStandardGiven", "given_name", testutils.Problems{}},
-		{"ValidStandardFamily", "family_name", testutils.Problems{}},
-		{"ValidStandardFull", "full_resource_name", testutils.Problems{}},
-		{"SkipValidDisplayNameSuffix", "foo_display_name", testutils.Problems{}},
-		{"Invalid", "author_path", testutils.Problems{{Suggestion: "author"}}},
+		{"Valid", "publisher", ruletest.Problems{}},
+		{"ValidStandardDisplay", "display_name", ruletest.Problems{}},
+		{"ValidStandardGiven", "given_name", ruletest.Problems{}},
+		{"ValidStandardFamily", "family_name", ruletest.Problems{}},
+		{"ValidStandardFull", "full_resource_name", ruletest.Problems{}},
+		{"SkipValidDisplayNameSuffix", "foo_display_name", ruletest.Problems{}},
+		{"Invalid", "author_path", ruletest.Problems{{Suggestion: "author"}}},
 	} {
-		f := testutils.ParseProto3Tmpl(t, `
+		f := ruletest.ParseProto3Tmpl(t, `
 			message Book {
 				string name = 1;
 				string {{.FieldName}} = 2;
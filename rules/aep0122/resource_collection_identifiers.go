@@ -18,9 +18,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -29,11 +29,11 @@ var firstCharRegexp = regexp.MustCompile(`^[a-z]`)
 var resourceCollectionIdentifiers = &lint.MessageRule{
 	Name: lint.NewRuleName(122, "resource-collection-identifiers"),
 	OnlyIf: func(m *desc.MessageDescriptor) bool {
-		return utils.GetResource(m) != nil
+		return descutil.GetResource(m) != nil
 	},
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		var problems []lint.Problem
-		resource := utils.GetResource(m)
+		resource := descutil.GetResource(m)
 		for _, p := range resource.GetPattern() {
 			if !firstCharRegexp.MatchString(p) {
 				return append(problems, lint.Problem{
@@ -17,24 +17,24 @@ package aep0122
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceCollectionIdentifiers(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		Pattern  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "author/{author}/books/{book}", testutils.Problems{}},
-		{"ValidWithIdSuffix", "stores/{store_id}/items/{item_id}", testutils.Problems{}},
-		{"InvalidCapitalIdSuffix", "stores/{Store_id}/items/{item_id}", testutils.Problems{{Message: "lowercase"}}},
-		{"InvalidUpperCase", "author/{author}/Books/{book}", testutils.Problems{{Message: "kebab-case"}}},
-		{"InvalidStartsWithSlash", "/author/{author}/Books/{book}", testutils.Problems{{Message: "lowercase letter"}}},
-		{"InvalidStartsWithCapitalLetter", "Author/{author}/Books/{book}", testutils.Problems{{Message: "lowercase letter"}}},
+		{"Valid", "author/{author}/books/{book}", ruletest.Problems{}},
+		{"ValidWithIdSuffix", "stores/{store_id}/items/{item_id}", ruletest.Problems{}},
+		{"InvalidCapitalIdSuffix", "stores/{Store_id}/items/{item_id}", ruletest.Problems{{Message: "lowercase"}}},
+		{"InvalidUpperCase", "author/{author}/Books/{book}", ruletest.Problems{{Message: "kebab-case"}}},
+		{"InvalidStartsWithSlash", "/author/{author}/Books/{book}", ruletest.Problems{{Message: "lowercase letter"}}},
+		{"InvalidStartsWithCapitalLetter", "Author/{author}/Books/{book}", ruletest.Problems{{Message: "lowercase letter"}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			message Book {
 				option (aep.api.resource) = {
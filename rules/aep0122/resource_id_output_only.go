@@ -15,8 +15,8 @@
 package aep0122
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 	"google.golang.org/genproto/googleapis/api/annotations"
@@ -30,12 +30,12 @@ var resourceIdOutputOnly = &lint.FieldRule{
 
 		// Build an expected ID field name based on the Resource `singular`
 		// field or by parsing the `type`.
-		isRes := utils.IsResource(p)
+		isRes := descutil.IsResource(p)
 		if isRes {
-			res := utils.GetResource(p)
+			res := descutil.GetResource(p)
 			idName = res.GetSingular()
 			if idName == "" {
-				if _, t, ok := utils.SplitResourceTypeName(res.GetType()); ok {
+				if _, t, ok := descutil.SplitResourceTypeName(res.GetType()); ok {
 					idName = strcase.SnakeCase(t)
 				}
 			}
@@ -46,7 +46,7 @@ var resourceIdOutputOnly = &lint.FieldRule{
 		return isRes && isId
 	},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
-		behaviors := utils.GetFieldBehavior(f)
+		behaviors := descutil.GetFieldBehavior(f)
 		if !behaviors.Contains(annotations.FieldBehavior_OUTPUT_ONLY.String()) {
 			return []lint.Problem{
 				{
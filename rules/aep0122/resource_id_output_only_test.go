@@ -17,7 +17,7 @@ package aep0122
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceIdOutputOnly(t *testing.T) {
@@ -25,15 +25,15 @@ func TestResourceIdOutputOnly(t *testing.T) {
 		name          string
 		FieldName     string
 		FieldBehavior string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
-		{"ValidWithSuffix", "book_id", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", testutils.Problems{}},
-		{"ValidUID", "uid", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", testutils.Problems{}},
-		{"InvalidWithSuffix", "book_id", "", testutils.Problems{{Message: "OUTPUT_ONLY"}}},
-		{"InvalidUID", "uid", "", testutils.Problems{{Message: "OUTPUT_ONLY"}}},
-		{"SkipDifferentIdField", "foo_id", "", testutils.Problems{}},
+		{"ValidWithSuffix", "book_id", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", ruletest.Problems{}},
+		{"ValidUID", "uid", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", ruletest.Problems{}},
+		{"InvalidWithSuffix", "book_id", "", ruletest.Problems{{Message: "OUTPUT_ONLY"}}},
+		{"InvalidUID", "uid", "", ruletest.Problems{{Message: "OUTPUT_ONLY"}}},
+		{"SkipDifferentIdField", "foo_id", "", ruletest.Problems{}},
 	} {
-		f := testutils.ParseProto3Tmpl(t, `
+		f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
 			import "aep/api/field_info.proto";
 
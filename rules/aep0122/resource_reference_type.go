@@ -15,16 +15,16 @@
 package aep0122
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var resourceReferenceType = &lint.FieldRule{
 	Name: lint.NewRuleName(122, "resource-reference-type"),
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
-		if utils.GetResourceReference(f) != nil && utils.GetTypeName(f) != "string" {
+		if descutil.GetResourceReference(f) != nil && descutil.GetTypeName(f) != "string" {
 			return []lint.Problem{{
 				Message:    "The resource_reference annotation should only be used on string fields.",
 				Descriptor: f,
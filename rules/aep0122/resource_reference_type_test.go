@@ -17,7 +17,7 @@ package aep0122
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceReferenceType(t *testing.T) {
@@ -26,14 +26,14 @@ func TestResourceReferenceType(t *testing.T) {
 		name       string
 		Type       string
 		Annotation string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"ValidString", "string", ann, nil},
-		{"InvalidMessage", "Author", ann, testutils.Problems{{Message: "string fields"}}},
+		{"InvalidMessage", "Author", ann, ruletest.Problems{{Message: "string fields"}}},
 		{"IrrelevantNoAnnotation", "Author", "", nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 
@@ -27,7 +27,8 @@ import (
 )
 
 var unspecified = &lint.EnumRule{
-	Name: lint.NewRuleName(126, "unspecified"),
+	Name:     lint.NewRuleName(126, "unspecified"),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	LintEnum: func(e *desc.EnumDescriptor) []lint.Problem {
 		name := endNum.ReplaceAllString(e.GetName(), "${1}_${2}")
 		unspec := strings.ToUpper(strcase.SnakeCase(name) + "_UNSPECIFIED")
@@ -17,58 +17,9 @@ package aep0126
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/lint/linttest"
 )
 
 func TestUnspecified(t *testing.T) {
-	tests := []struct {
-		testName  string
-		EnumName  string
-		ValueName string
-		problems  testutils.Problems
-	}{
-		{"Valid", "BookFormat", "BOOK_FORMAT_UNSPECIFIED", testutils.Problems{}},
-		{"ValidWithNum", "Ipv6Format", "IPV6_FORMAT_UNSPECIFIED", nil},
-		{"ValidUnknown", "BookFormat", "UNKNOWN", nil},
-		{"InvalidNoPrefix", "BookFormat", "UNSPECIFIED", testutils.Problems{{Suggestion: "BOOK_FORMAT_UNSPECIFIED"}}},
-		{"InvalidWrongSuffix", "BookFormat", "BOOK_FORMAT_UNKNOWN", testutils.Problems{{Suggestion: "BOOK_FORMAT_UNSPECIFIED"}}},
-		{"InvalidWithNum", "Ipv6Format", "IPV6FORMAT_UNSPECIFIED", testutils.Problems{{Suggestion: "IPV6_FORMAT_UNSPECIFIED"}}},
-	}
-	for _, test := range tests {
-		t.Run(test.testName, func(t *testing.T) {
-			// Create the proto with the enum.
-			f := testutils.ParseProto3Tmpl(t, `
-				enum {{.EnumName}} {
-					{{.ValueName}} = 0;
-					HARDBACK = 1;
-					PAPERBACK = 2;
-				}
-			`, test)
-
-			// Run the lint rule and establish we get the correct problems.
-			problems := unspecified.Lint(f)
-			if diff := test.problems.SetDescriptor(f.GetEnumTypes()[0].GetValues()[0]).Diff(problems); diff != "" {
-				t.Error(diff)
-			}
-		})
-	}
-	for _, test := range tests {
-		t.Run(test.testName, func(t *testing.T) {
-			// Create the proto with the enum.
-			f := testutils.ParseProto3Tmpl(t, `
-				enum {{.EnumName}} {
-					option allow_alias = true;
-					HARDBACK = 0;
-					{{.ValueName}} = 0;
-					PAPERBACK = 1;
-				}
-			`, test)
-
-			// Run the lint rule and establish we get the correct problems.
-			problems := unspecified.Lint(f)
-			if diff := test.problems.SetDescriptor(f.GetEnumTypes()[0].GetValues()[0]).Diff(problems); diff != "" {
-				t.Error(diff)
-			}
-		})
-	}
+	linttest.RunWithSuggestions(t, "testdata", unspecified, "unspecified.proto")
 }
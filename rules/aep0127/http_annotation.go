@@ -15,16 +15,16 @@
 package aep0127
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var hasAnnotation = &lint.MethodRule{
 	Name: lint.NewRuleName(127, "http-annotation"),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		hasHTTPRule := len(utils.GetHTTPRules(m)) > 0
+		hasHTTPRule := len(descutil.GetHTTPRules(m)) > 0
 		if hasHTTPRule && m.IsClientStreaming() && m.IsServerStreaming() {
 			return []lint.Problem{{
 				Message:    "Bi-directional streaming RPCs should omit `google.api.http`.",
@@ -18,7 +18,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHasAnnotation(t *testing.T) {
@@ -28,22 +28,22 @@ func TestHasAnnotation(t *testing.T) {
 		Istream    string
 		Ostream    string
 		annotation string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"ValidUU", "", "", ann, testutils.Problems{}},
-		{"InvalidUU", "", "", "", testutils.Problems{{Message: "google.api.http"}}},
-		{"ValidUS", "", "stream ", ann, testutils.Problems{}},
-		{"InvalidUS", "", "stream ", "", testutils.Problems{{Message: "google.api.http"}}},
-		{"ValidSU", "stream ", "", ann, testutils.Problems{}},
-		{"InvalidSU", "stream ", "", "", testutils.Problems{{Message: "google.api.http"}}},
-		{"ValidSS", "stream ", "stream ", "", testutils.Problems{}},
-		{"InvalidSS", "stream ", "stream ", ann, testutils.Problems{{Message: "google.api.http"}}},
+		{"ValidUU", "", "", ann, ruletest.Problems{}},
+		{"InvalidUU", "", "", "", ruletest.Problems{{Message: "google.api.http"}}},
+		{"ValidUS", "", "stream ", ann, ruletest.Problems{}},
+		{"InvalidUS", "", "stream ", "", ruletest.Problems{{Message: "google.api.http"}}},
+		{"ValidSU", "stream ", "", ann, ruletest.Problems{}},
+		{"InvalidSU", "stream ", "", "", ruletest.Problems{{Message: "google.api.http"}}},
+		{"ValidSS", "stream ", "stream ", "", ruletest.Problems{}},
+		{"InvalidSS", "stream ", "stream ", ann, ruletest.Problems{{Message: "google.api.http"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			// Use of strings.ReplaceAll here allows a replacement using quotes,
 			// which Go templates has no way to get around.
-			f := testutils.ParseProto3Tmpl(t, strings.ReplaceAll(`
+			f := ruletest.ParseProto3Tmpl(t, strings.ReplaceAll(`
 				import "google/api/annotations.proto";
 				service Library {
 					rpc ReadBook({{.Istream}}ReadBookRequest) returns ({{.Ostream}}ReadBookResponse) {
@@ -19,9 +19,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -47,25 +47,25 @@ type resourceReference struct {
 // HTTPRule's.
 func methodResourceReferences(m *desc.MethodDescriptor) []resourceReference {
 	resourceRefs := []resourceReference{}
-	for _, httpRule := range utils.GetHTTPRules(m) {
+	for _, httpRule := range descutil.GetHTTPRules(m) {
 		resourceRefs = append(resourceRefs, httpResourceReferences(httpRule, m.GetInputType())...)
 	}
 	return resourceRefs
 }
 
 // Returns a resourceReference for every variable in the given HTTPRule.
-func httpResourceReferences(httpRule *utils.HTTPRule, msg *desc.MessageDescriptor) []resourceReference {
+func httpResourceReferences(httpRule *descutil.HTTPRule, msg *desc.MessageDescriptor) []resourceReference {
 	resourceRefs := []resourceReference{}
 	for fieldPath, template := range httpRule.GetVariables() {
 		// Find the (sub-)field in the message corresponding to the variable's
 		// field path.
-		field := utils.FindFieldDotNotation(msg, fieldPath)
+		field := descutil.FindFieldDotNotation(msg, fieldPath)
 		if field == nil {
 			continue
 		}
 
 		// Extract the name of the resource referenced by this field.
-		ref := utils.GetResourceReference(field)
+		ref := descutil.GetResourceReference(field)
 		if ref == nil || len(ref.GetChildType()) > 0 {
 			// TODO(#1047): Support the case where a resource has
 			// multiple parent resources.
@@ -105,7 +105,7 @@ func anyMatch(regex *regexp.Regexp, strs []string) bool {
 // Checks whether the HTTP pattern specified in `resourceRef` matches any of the
 // patterns defined for that resource.
 func checkHTTPPatternMatchesResource(m *desc.MethodDescriptor, resourceRef resourceReference) []lint.Problem {
-	annotation := utils.FindResource(resourceRef.resourceRefName, m.GetFile())
+	annotation := descutil.FindResource(resourceRef.resourceRefName, m.GetFile())
 	if annotation == nil {
 		return []lint.Problem{}
 	}
@@ -117,7 +117,7 @@ func checkHTTPPatternMatchesResource(m *desc.MethodDescriptor, resourceRef resou
 
 	if !anyMatch(pathRegex, annotation.GetPattern()) {
 		message := fmt.Sprintf("The HTTP pattern %q does not match any of the patterns for resource %q", resourceRef.pathTemplate, resourceRef.resourceRefName)
-		return []lint.Problem{{Message: message, Descriptor: m, Location: locations.MethodHTTPRule(m)}}
+		return []lint.Problem{{Message: message, Descriptor: m, Location: locations.HTTPPattern(m)}}
 	}
 
 	return []lint.Problem{}
@@ -17,7 +17,7 @@ package aep0127
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpTemplatePattern_PatternMatching(t *testing.T) {
@@ -25,17 +25,17 @@ func TestHttpTemplatePattern_PatternMatching(t *testing.T) {
 		name            string
 		HTTPAnnotation  string
 		ResourcePattern string
-		problems        testutils.Problems
+		problems        ruletest.Problems
 	}{
 		// HTTP variable uses literals
 		{"LiteralMatchesSameLiteralInPattern", "/v1/{path=shelves}", "shelves", nil},
-		{"LiteralDoesNotMatchDifferentLiteral", "/v1/{path=shelves}", "books", testutils.Problems{{Message: "does not match"}}},
+		{"LiteralDoesNotMatchDifferentLiteral", "/v1/{path=shelves}", "books", ruletest.Problems{{Message: "does not match"}}},
 		{"SuffixAfterHttpVariableIgnoredForMatch", "/v1/{path=shelves}/books", "shelves", nil},
 
 		// HTTP variable uses single wildcard
 		{"SingleWildcardMatchesAnyLiteralSegment", "/v1/{path=*}", "shelves", nil},
 		{"SingleWildcardMatchesAnyVariableSegment", "/v1/{path=*}", "{shelf}", nil},
-		{"SingleWildcardDoesNotMatchMultipleUrlSegments", "/v1/{path=*}", "shelves/{shelf}", testutils.Problems{{Message: "does not match"}}},
+		{"SingleWildcardDoesNotMatchMultipleUrlSegments", "/v1/{path=*}", "shelves/{shelf}", ruletest.Problems{{Message: "does not match"}}},
 		{"LiteralAndWildcardMatch", "/v1/{path=shelves/*}", "shelves/{shelf}", nil},
 		// This case is only theoretical, as "{shelf}" represents a resource ID,
 		// rather than a resource path. It should not be observed in practice.
@@ -51,11 +51,11 @@ func TestHttpTemplatePattern_PatternMatching(t *testing.T) {
 		{"DoubleWildcardMatchesMixedLiteralVariableSegments", "/v1/{path=**}", "shelves/{shelf}", nil},
 		{"DoubleWildcardPrecededByLiteralMatches", "/v1/{path=shelves/**}", "shelves/{shelf}", nil},
 		{"DoubleWildcardFollowedByLiteralMatches", "/v1/{path=**/shelves/*}", "my/shelves/{shelf}", nil},
-		{"DoubleWildcardMissingLiteralDoesNotMatch", "/v1/{path=shelves/**}", "{shelf}", testutils.Problems{{Message: "does not match"}}},
+		{"DoubleWildcardMissingLiteralDoesNotMatch", "/v1/{path=shelves/**}", "{shelf}", ruletest.Problems{{Message: "does not match"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
@@ -92,15 +92,15 @@ func TestHttpTemplatePattern_MultiplePatterns(t *testing.T) {
 		HTTPAnnotation   string
 		ResourcePattern1 string
 		ResourcePattern2 string
-		problems         testutils.Problems
+		problems         ruletest.Problems
 	}{
 		{"MatchesIfFirstPatternMatches", "/v1/{path=shelves}", "shelves", "books", nil},
 		{"MatchesIfSecondPatternMatches", "/v1/{path=shelves}", "books", "shelves", nil},
-		{"FailsIfNeitherPatternMatches", "/v1/{path=shelves}", "books", "bins", testutils.Problems{{Message: "does not match"}}},
+		{"FailsIfNeitherPatternMatches", "/v1/{path=shelves}", "books", "bins", ruletest.Problems{{Message: "does not match"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
@@ -132,7 +132,7 @@ func TestHttpTemplatePattern_MultiplePatterns(t *testing.T) {
 }
 
 func TestHttpTemplatePattern_SkipCheckIfNoHTTPRules(t *testing.T) {
-	f := testutils.ParseProto3String(t, `
+	f := ruletest.ParseProto3String(t, `
 			import "google/api/annotations.proto";
 			import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
@@ -155,7 +155,7 @@ func TestHttpTemplatePattern_SkipCheckIfNoHTTPRules(t *testing.T) {
 }
 
 func TestHttpTemplatePattern_SkipCheckIfHTTPRuleHasNoVariables(t *testing.T) {
-	f := testutils.ParseProto3String(t, `
+	f := ruletest.ParseProto3String(t, `
 			import "google/api/annotations.proto";
 			import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
@@ -182,7 +182,7 @@ func TestHttpTemplatePattern_SkipCheckIfHTTPRuleHasNoVariables(t *testing.T) {
 }
 
 func TestHttpTemplatePattern_SkipCheckIfFieldPathMissingResourceAnnotation(t *testing.T) {
-	f := testutils.ParseProto3String(t, `
+	f := ruletest.ParseProto3String(t, `
 			import "google/api/annotations.proto";
 			import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
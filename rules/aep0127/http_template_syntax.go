@@ -18,9 +18,9 @@ import (
 	"fmt"
 	"regexp"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -42,19 +42,19 @@ var (
 // https://github.com/googleapis/googleapis/blob/16db2fb7fab4668bdfa09966513e03581d8f5e35/google/api/http.proto#L224.
 var httpTemplateSyntax = &lint.MethodRule{
 	Name:   lint.NewRuleName(127, "http-template-syntax"),
-	OnlyIf: utils.HasHTTPRules,
+	OnlyIf: descutil.HasHTTPRules,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		problems := []lint.Problem{}
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for _, httpRule := range descutil.GetHTTPRules(m) {
 			// Replace the API Versioning template if it matches exactly so as
 			// to not emit false positives.
-			uri := utils.VersionedSegment.ReplaceAllString(httpRule.URI, "v")
+			uri := descutil.VersionedSegment.ReplaceAllString(httpRule.URI, "v")
 			if !templateRegex.MatchString(uri) {
 				message := fmt.Sprintf("The HTTP pattern %q does not follow proper HTTP path template syntax", httpRule.URI)
 				problems = append(problems, lint.Problem{
 					Message:    message,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.HTTPPattern(m),
 				})
 			}
 		}
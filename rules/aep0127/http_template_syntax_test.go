@@ -17,7 +17,7 @@ package aep0127
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpTemplateSyntax(t *testing.T) {
@@ -79,7 +79,7 @@ func TestHttpTemplateSyntax(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc FooMethod(FooMethodRequest) returns (FooMethodResponse) {
@@ -15,22 +15,22 @@
 package aep0127
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var resourcePathExtraction = &lint.MethodRule{
 	Name: lint.NewRuleName(127, "resource-path-extraction"),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, rule := range utils.GetHTTPRules(m) {
+		for _, rule := range descutil.GetHTTPRules(m) {
 			for k, v := range rule.GetVariables() {
 				if v == "*" && k != "$api_version" {
 					return []lint.Problem{{
 						Message:    "Extract a full resource path into a variable, not just IDs.",
 						Descriptor: m,
-						Location:   locations.MethodHTTPRule(m),
+						Location:   locations.HTTPPattern(m),
 					}}
 				}
 			}
@@ -18,21 +18,21 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourcePathExtraction(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		uri      string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "/v1/{path=publishers/*/books/*}", testutils.Problems{}},
-		{"VersioningTool", "/{$api_version}/{path=publishers/*/books/*}", testutils.Problems{}},
-		{"Invalid", "/v1/publishers/{publisher_id}/books/{book_id}", testutils.Problems{{Message: "full resource path"}}},
+		{"Valid", "/v1/{path=publishers/*/books/*}", ruletest.Problems{}},
+		{"VersioningTool", "/{$api_version}/{path=publishers/*/books/*}", ruletest.Problems{}},
+		{"Invalid", "/v1/publishers/{publisher_id}/books/{book_id}", ruletest.Problems{{Message: "full resource path"}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3String(t, strings.ReplaceAll(`
+			f := ruletest.ParseProto3String(t, strings.ReplaceAll(`
 				import "google/api/annotations.proto";
 				service Library {
 					rpc GetBook(GetBookRequest) returns (Book) {
@@ -17,21 +17,21 @@ package aep0127
 import (
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var leadingSlash = &lint.MethodRule{
 	Name: lint.NewRuleName(127, "uri-leading-slash"),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, http := range utils.GetHTTPRules(m) {
+		for _, http := range descutil.GetHTTPRules(m) {
 			if !strings.HasPrefix(http.GetPlainURI(), "/") {
 				return []lint.Problem{{
 					Message:    "URIs must begin with a leading slash.",
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.HTTPPattern(m),
 				}}
 			}
 		}
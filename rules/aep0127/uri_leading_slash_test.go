@@ -17,19 +17,19 @@ package aep0127
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestURILeadingSlash(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		URI      string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "/v1/{name=publishers/*/books/*}", nil},
-		{"Invalid", "v1/{name=publishers/*/books/*}", testutils.Problems{{Message: "leading slash"}}},
+		{"Invalid", "v1/{name=publishers/*/books/*}", ruletest.Problems{{Message: "leading slash"}}},
 	} {
-		f := testutils.ParseProto3Tmpl(t, `
+		f := ruletest.ParseProto3Tmpl(t, `
 			import "google/api/annotations.proto";
 			import "google/protobuf/empty.proto";
 
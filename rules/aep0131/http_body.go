@@ -15,14 +15,14 @@
 package aep0131
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Get methods should not have an HTTP body.
 var httpBody = &lint.MethodRule{
 	Name:       lint.NewRuleName(131, "http-body"),
-	OnlyIf:     utils.IsGetMethod,
-	LintMethod: utils.LintNoHTTPBody,
+	OnlyIf:     descutil.IsGetMethod,
+	LintMethod: descutil.LintNoHTTPBody,
 	RuleType:   lint.NewRuleType(lint.MustRule),
 }
@@ -15,14 +15,14 @@
 package aep0131
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Get methods should use the HTTP GET verb.
 var httpMethod = &lint.MethodRule{
 	Name:       lint.NewRuleName(131, "http-method"),
-	OnlyIf:     utils.IsGetMethod,
-	LintMethod: utils.LintHTTPMethod("GET"),
+	OnlyIf:     descutil.IsGetMethod,
+	LintMethod: descutil.LintHTTPMethod("GET"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
 }
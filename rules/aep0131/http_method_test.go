@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpMethod(t *testing.T) {
@@ -26,17 +26,17 @@ func TestHttpMethod(t *testing.T) {
 		testName   string
 		Method     string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", "get", "GetBook", nil},
-		{"Invalid", "post", "GetBook", testutils.Problems{{Message: "HTTP GET"}}},
+		{"Invalid", "post", "GetBook", ruletest.Problems{{Message: "HTTP GET"}}},
 		{"Irrelevant", "post", "AcquireBook", nil},
 	}
 
 	// Run each test.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
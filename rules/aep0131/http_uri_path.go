@@ -15,14 +15,14 @@
 package aep0131
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Get methods should have a proper HTTP pattern.
 var httpPathField = &lint.MethodRule{
 	Name:       lint.NewRuleName(131, "http-uri-path"),
-	OnlyIf:     utils.IsGetMethod,
-	LintMethod: utils.LintHTTPURIHasPathVariable,
+	OnlyIf:     descutil.IsGetMethod,
+	LintMethod: descutil.LintHTTPURIHasPathVariable,
 	RuleType:   lint.NewRuleType(lint.MustRule),
 }
@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpPathField(t *testing.T) {
@@ -25,17 +25,17 @@ func TestHttpPathField(t *testing.T) {
 		testName   string
 		URI        string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"Valid", "/v1/{path=publishers/*/books/*}", "GetBook", testutils.Problems{}},
-		{"InvalidVarName", "/v1/{book=publishers/*/books/*}", "GetBook", testutils.Problems{{Message: "`path`"}}},
-		{"NoVarName", "/v1/publishers/*/books/*", "GetBook", testutils.Problems{{Message: "`path`"}}},
-		{"Irrelevant", "/v1/{book=publishers/*/books/*}", "AcquireBook", testutils.Problems{}},
+		{"Valid", "/v1/{path=publishers/*/books/*}", "GetBook", ruletest.Problems{}},
+		{"InvalidVarName", "/v1/{book=publishers/*/books/*}", "GetBook", ruletest.Problems{{Message: "`path`"}}},
+		{"NoVarName", "/v1/publishers/*/books/*", "GetBook", ruletest.Problems{{Message: "`path`"}}},
+		{"Irrelevant", "/v1/{book=publishers/*/books/*}", "AcquireBook", ruletest.Problems{}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns ({{.MethodName}}Response) {
@@ -18,17 +18,18 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var methodSignature = &lint.MethodRule{
-	Name:   lint.NewRuleName(131, "method-signature"),
-	OnlyIf: utils.IsGetMethod,
+	Name:     lint.NewRuleName(131, "method-signature"),
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsGetMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		signatures := utils.GetMethodSignatures(m)
+		signatures := descutil.GetMethodSignatures(m)
 
 		// Check if the signature is missing.
 		if len(signatures) == 0 {
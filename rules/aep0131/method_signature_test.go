@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestMethodSignature(t *testing.T) {
@@ -25,20 +25,20 @@ func TestMethodSignature(t *testing.T) {
 		name       string
 		MethodName string
 		Signature  string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"Valid", "GetBook", `option (google.api.method_signature) = "path";`, testutils.Problems{}},
-		{"Missing", "GetBook", "", testutils.Problems{{Message: `(google.api.method_signature) = "path"`}}},
+		{"Valid", "GetBook", `option (google.api.method_signature) = "path";`, ruletest.Problems{}},
+		{"Missing", "GetBook", "", ruletest.Problems{{Message: `(google.api.method_signature) = "path"`}}},
 		{
 			"Wrong",
 			"GetBook",
 			`option (google.api.method_signature) = "book";`,
-			testutils.Problems{{Suggestion: `option (google.api.method_signature) = "path";`}},
+			ruletest.Problems{{Suggestion: `option (google.api.method_signature) = "path";`}},
 		},
-		{"Irrelevant", "ReadBook", "", testutils.Problems{}},
+		{"Irrelevant", "ReadBook", "", ruletest.Problems{}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/client.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
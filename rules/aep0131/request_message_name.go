@@ -15,14 +15,15 @@
 package aep0131
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Get messages should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
 	Name:       lint.NewRuleName(131, "request-message-name"),
-	OnlyIf:     utils.IsGetMethod,
-	LintMethod: utils.LintMethodHasMatchingRequestName,
+	Metadata:   lint.RuleMetadata{Fixable: true},
+	OnlyIf:     descutil.IsGetMethod,
+	LintMethod: descutil.LintMethodHasMatchingRequestName,
 	// TODO: Enable rule type once integration tests are fixed.
 }
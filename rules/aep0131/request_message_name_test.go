@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestMessageName(t *testing.T) {
@@ -26,18 +26,18 @@ func TestRequestMessageName(t *testing.T) {
 		testName       string
 		MethodName     string
 		ReqMessageName string
-		problems       testutils.Problems
+		problems       ruletest.Problems
 	}{
-		{"Valid", "GetBook", "GetBookRequest", testutils.Problems{}},
-		{"Invalid", "GetBook", "Book", testutils.Problems{{Suggestion: "GetBookRequest"}}},
-		{"GetIamPolicy", "GetIamPolicy", "GetIamPolicyRequest", testutils.Problems{}},
-		{"Irrelevant", "AcquireBook", "Book", testutils.Problems{}},
+		{"Valid", "GetBook", "GetBookRequest", ruletest.Problems{}},
+		{"Invalid", "GetBook", "Book", ruletest.Problems{{Suggestion: "GetBookRequest"}}},
+		{"GetIamPolicy", "GetIamPolicy", "GetIamPolicyRequest", ruletest.Problems{}},
+		{"Irrelevant", "AcquireBook", "Book", ruletest.Problems{}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.ReqMessageName}}) returns (Book) {}
 				}
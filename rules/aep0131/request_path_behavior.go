@@ -15,16 +15,16 @@
 package aep0131
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestPathBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(131, "request-path-behavior"),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsGetRequestMessage(f.GetOwner()) && f.GetName() == "path"
+		return descutil.IsGetRequestMessage(f.GetOwner()) && f.GetName() == "path"
 	},
-	LintField: utils.LintRequiredField,
+	LintField: descutil.LintRequiredField,
 	RuleType:  lint.NewRuleType(lint.MustRule),
 }
@@ -15,17 +15,18 @@
 package aep0131
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 // Get request should have a string path field.
 var requestPathField = &lint.FieldRule{
-	Name: lint.NewRuleName(131, "request-path-field"),
+	Name:     lint.NewRuleName(131, "request-path-field"),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsGetRequestMessage(f.GetOwner()) && f.GetName() == "path"
+		return descutil.IsGetRequestMessage(f.GetOwner()) && f.GetName() == "path"
 	},
-	LintField: utils.LintSingularStringField,
+	LintField: descutil.LintSingularStringField,
 	RuleType:  lint.NewRuleType(lint.MustRule),
 }
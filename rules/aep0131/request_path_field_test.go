@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestNameFieldType(t *testing.T) {
@@ -25,16 +25,16 @@ func TestRequestNameFieldType(t *testing.T) {
 		name          string
 		MessageName   string
 		PathFieldType string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
 		{"StringNameFieldType_Valid", "GetBookRequest", "string", nil},
-		{"BytesNameFieldType_Invalid", "GetBookRequest", "bytes", testutils.Problems{{Suggestion: "string"}}},
+		{"BytesNameFieldType_Invalid", "GetBookRequest", "bytes", ruletest.Problems{{Suggestion: "string"}}},
 		{"NotGetRequest_BytesNameFieldType_Valid", "SomeMessage", "bytes", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			message {{.MessageName}} {
 				{{.PathFieldType}} path = 1;
 			}`, test)
@@ -15,16 +15,16 @@
 package aep0131
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestPathReference = &lint.FieldRule{
 	Name: lint.NewRuleName(131, "request-path-reference"),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsGetRequestMessage(f.GetOwner()) && f.GetName() == "path"
+		return descutil.IsGetRequestMessage(f.GetOwner()) && f.GetName() == "path"
 	},
-	LintField: utils.LintFieldResourceReference,
+	LintField: descutil.LintFieldResourceReference,
 	RuleType:  lint.NewRuleType(lint.ShouldRule),
 }
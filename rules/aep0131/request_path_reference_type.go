@@ -17,18 +17,18 @@ package aep0131
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestPathReferenceType = &lint.FieldRule{
 	Name: lint.NewRuleName(131, "request-path-reference-type"),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsGetRequestMessage(f.GetOwner()) && f.GetName() == "path" && utils.GetResourceReference(f) != nil
+		return descutil.IsGetRequestMessage(f.GetOwner()) && f.GetName() == "path" && descutil.GetResourceReference(f) != nil
 	},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
-		if ref := utils.GetResourceReference(f); len(ref.GetType()) == 0 || ref.GetType()[0] == "" {
+		if ref := descutil.GetResourceReference(f); len(ref.GetType()) == 0 || ref.GetType()[0] == "" {
 			return []lint.Problem{{
 				Message:    fmt.Sprintf("The `%s` field `(aep.api.field_info).resource_reference` annotation should be a direct `type` reference.", f.GetName()),
 				Descriptor: f,
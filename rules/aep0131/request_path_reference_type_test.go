@@ -17,20 +17,20 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestNameReferenceType(t *testing.T) {
 	for _, test := range []struct {
 		testName   string
 		Annotation string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", `[(aep.api.field_info).resource_reference = "library.googleapis.com/Book"]`, nil},
-		{"Invalid", `[(aep.api.field_info).resource_reference = ""]`, testutils.Problems{{Message: "should be a direct"}}},
+		{"Invalid", `[(aep.api.field_info).resource_reference = ""]`, ruletest.Problems{{Message: "should be a direct"}}},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 				message GetBookRequest {
@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestHasNameField(t *testing.T) {
@@ -26,17 +26,17 @@ func TestRequestHasNameField(t *testing.T) {
 		testName    string
 		MessageName string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
-		{"Valid", "GetBookRequest", "path", testutils.Problems{}},
-		{"InvalidName", "GetBookRequest", "id", testutils.Problems{{Message: "path"}}},
-		{"Irrelevant", "AcquireBookRequest", "id", testutils.Problems{}},
+		{"Valid", "GetBookRequest", "path", ruletest.Problems{}},
+		{"InvalidName", "GetBookRequest", "id", ruletest.Problems{{Message: "path"}}},
+		{"Irrelevant", "AcquireBookRequest", "id", ruletest.Problems{}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			message {{.MessageName}} {
 				string {{.FieldName}} = 1;
 			}`, test)
@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -26,7 +26,7 @@ func TestRequiredFieldTests(t *testing.T) {
 		name                 string
 		Fields               string
 		problematicFieldName string
-		problems             testutils.Problems
+		problems             ruletest.Problems
 	}{
 		{
 			"ValidNoExtraFields",
@@ -44,7 +44,7 @@ func TestRequiredFieldTests(t *testing.T) {
 			"InvalidRequiredReadMask",
 			"google.protobuf.FieldMask read_mask = 2 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];",
 			"read_mask",
-			testutils.Problems{
+			ruletest.Problems{
 				{Message: `Get RPCs must only require fields explicitly described in AEPs, not "read_mask"`},
 			},
 		},
@@ -52,13 +52,13 @@ func TestRequiredFieldTests(t *testing.T) {
 			"InvalidRequiredUnknownField",
 			"bool create_iam = 3 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];",
 			"create_iam",
-			testutils.Problems{
+			ruletest.Problems{
 				{Message: `Get RPCs must only require fields explicitly described in AEPs, not "create_iam"`},
 			},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/field_info.proto";
 				import "aep/api/resource.proto";
@@ -19,8 +19,8 @@ import (
 
 	"bitbucket.org/creachadair/stringset"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -36,7 +36,7 @@ var allowedFields = stringset.New(
 var unknownFields = &lint.FieldRule{
 	Name: lint.NewRuleName(131, "request-unknown-fields"),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsGetRequestMessage(f.GetOwner())
+		return descutil.IsGetRequestMessage(f.GetOwner())
 	},
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintField: func(field *desc.FieldDescriptor) []lint.Problem {
@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 	fpb "google.golang.org/genproto/protobuf/field_mask"
@@ -36,16 +36,16 @@ func TestUnknownFields(t *testing.T) {
 		messageName string
 		fieldName   string
 		fieldType   *builder.FieldType
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
-		{"RequestId", "GetBookRequest", "request_id", builder.FieldTypeImportedMessage(fieldMask), testutils.Problems{}},
-		{"ReadMask", "GetBookRequest", "read_mask", builder.FieldTypeImportedMessage(fieldMask), testutils.Problems{}},
-		{"View", "GetBookRequest", "view", builder.FieldTypeEnum(builder.NewEnum("View").AddValue(builder.NewEnumValue("BASIC"))), testutils.Problems{}},
-		{"Invalid", "GetBookRequest", "application_id", builder.FieldTypeString(), testutils.Problems{{
+		{"RequestId", "GetBookRequest", "request_id", builder.FieldTypeImportedMessage(fieldMask), ruletest.Problems{}},
+		{"ReadMask", "GetBookRequest", "read_mask", builder.FieldTypeImportedMessage(fieldMask), ruletest.Problems{}},
+		{"View", "GetBookRequest", "view", builder.FieldTypeEnum(builder.NewEnum("View").AddValue(builder.NewEnumValue("BASIC"))), ruletest.Problems{}},
+		{"Invalid", "GetBookRequest", "application_id", builder.FieldTypeString(), ruletest.Problems{{
 			Message: "Unexpected field",
 		}}},
-		{"ShowDeleted", "GetBookRequest", "show_deleted", builder.FieldTypeBool(), testutils.Problems{}},
-		{"Irrelevant", "AcquireBookRequest", "application_id", builder.FieldTypeString(), testutils.Problems{}},
+		{"ShowDeleted", "GetBookRequest", "show_deleted", builder.FieldTypeBool(), ruletest.Problems{}},
+		{"Irrelevant", "AcquireBookRequest", "application_id", builder.FieldTypeString(), ruletest.Problems{}},
 	}
 
 	// Run each test individually.
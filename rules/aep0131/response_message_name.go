@@ -17,16 +17,17 @@ package aep0131
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 // Get messages should use the resource as the response message
 var responseMessageName = &lint.MethodRule{
 	Name:     lint.NewRuleName(131, "response-message-name"),
-	OnlyIf:   utils.IsGetMethod,
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsGetMethod,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `GetFoo`, the response
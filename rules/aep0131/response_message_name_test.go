@@ -17,7 +17,7 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResponseMessageName(t *testing.T) {
@@ -26,17 +26,17 @@ func TestResponseMessageName(t *testing.T) {
 		testName        string
 		MethodName      string
 		RespMessageName string
-		problems        testutils.Problems
+		problems        ruletest.Problems
 	}{
-		{"Valid", "GetBook", "Book", testutils.Problems{}},
-		{"Invalid", "GetBook", "GetBookResponse", testutils.Problems{{Suggestion: "Book"}}},
-		{"Irrelevant", "AcquireBook", "AcquireBookResponse", testutils.Problems{}},
+		{"Valid", "GetBook", "Book", ruletest.Problems{}},
+		{"Invalid", "GetBook", "GetBookResponse", ruletest.Problems{{Suggestion: "Book"}}},
+		{"Irrelevant", "AcquireBook", "AcquireBookResponse", ruletest.Problems{}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns ({{.RespMessageName}}) {}
 				}
@@ -27,6 +27,7 @@ import (
 var synonyms = &lint.MethodRule{
 	Name:     lint.NewRuleName(131, "synonyms"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		name := m.GetName()
 		for _, syn := range []string{"Acquire", "Fetch", "Lookup", "Read", "Retrieve"} {
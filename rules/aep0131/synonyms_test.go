@@ -17,23 +17,23 @@ package aep0131
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestSynonyms(t *testing.T) {
 	tests := []struct {
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"GetBook", testutils.Problems{}},
-		{"AcquireBook", testutils.Problems{{Suggestion: "GetBook"}}},
-		{"FetchBook", testutils.Problems{{Suggestion: "GetBook"}}},
-		{"LookupBook", testutils.Problems{{Suggestion: "GetBook"}}},
-		{"ReadBook", testutils.Problems{{Suggestion: "GetBook"}}},
-		{"RetrieveBook", testutils.Problems{{Suggestion: "GetBook"}}},
+		{"GetBook", ruletest.Problems{}},
+		{"AcquireBook", ruletest.Problems{{Suggestion: "GetBook"}}},
+		{"FetchBook", ruletest.Problems{{Suggestion: "GetBook"}}},
+		{"LookupBook", ruletest.Problems{{Suggestion: "GetBook"}}},
+		{"ReadBook", ruletest.Problems{{Suggestion: "GetBook"}}},
+		{"RetrieveBook", ruletest.Problems{{Suggestion: "GetBook"}}},
 	}
 	for _, test := range tests {
-		file := testutils.ParseProto3Tmpl(t, `
+		file := ruletest.ParseProto3Tmpl(t, `
 			service Library {
 				rpc {{.MethodName}}({{.MethodName}}Request) returns (Book);
 			}
@@ -18,20 +18,21 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var methodSignature = &lint.MethodRule{
 	Name:     lint.NewRuleName(132, "method-signature"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(m *desc.MethodDescriptor) bool {
-		return utils.IsListMethod(m) && m.GetInputType().FindFieldByName("parent") != nil
+		return descutil.IsListMethod(m) && m.GetInputType().FindFieldByName("parent") != nil
 	},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		signatures := utils.GetMethodSignatures(m)
+		signatures := descutil.GetMethodSignatures(m)
 
 		// Check if the signature is missing.
 		if len(signatures) == 0 {
@@ -17,7 +17,7 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestMethodSignature(t *testing.T) {
@@ -25,20 +25,20 @@ func TestMethodSignature(t *testing.T) {
 		name       string
 		MethodName string
 		Signature  string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"Valid", "ListBooks", `option (google.api.method_signature) = "parent";`, testutils.Problems{}},
-		{"Missing", "ListBooks", "", testutils.Problems{{Message: `(google.api.method_signature) = "parent"`}}},
+		{"Valid", "ListBooks", `option (google.api.method_signature) = "parent";`, ruletest.Problems{}},
+		{"Missing", "ListBooks", "", ruletest.Problems{{Message: `(google.api.method_signature) = "parent"`}}},
 		{
 			"Wrong",
 			"ListBooks",
 			`option (google.api.method_signature) = "publisher";`,
-			testutils.Problems{{Suggestion: `option (google.api.method_signature) = "parent";`}},
+			ruletest.Problems{{Suggestion: `option (google.api.method_signature) = "parent";`}},
 		},
-		{"Irrelevant", "BrowseBooks", "", testutils.Problems{}},
+		{"Irrelevant", "BrowseBooks", "", ruletest.Problems{}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/client.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
@@ -59,7 +59,7 @@ func TestMethodSignature(t *testing.T) {
 
 	// Run a special test for a missing parent.
 	t.Run("MissingParent", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "google/api/client.proto";
 			service Library {
 				rpc ListBooks(ListBooksRequest) returns (Book);
@@ -67,7 +67,7 @@ func TestMethodSignature(t *testing.T) {
 			message ListBooksRequest {}
 			message Book {}
 		`)
-		if diff := (testutils.Problems{}).Diff(methodSignature.Lint(f)); diff != "" {
+		if diff := (ruletest.Problems{}).Diff(methodSignature.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
 	})
@@ -15,23 +15,24 @@
 package aep0132
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var knownFields = map[string]func(*desc.FieldDescriptor) []lint.Problem{
-	"filter":       utils.LintSingularStringField,
-	"order_by":     utils.LintSingularStringField,
-	"show_deleted": utils.LintSingularBoolField,
+	"filter":       descutil.LintSingularStringField,
+	"order_by":     descutil.LintSingularStringField,
+	"show_deleted": descutil.LintSingularBoolField,
 }
 
 // List fields should have the correct type.
 var requestFieldTypes = &lint.FieldRule{
 	Name:     lint.NewRuleName(132, "request-field-types"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsListRequestMessage(f.GetOwner()) && knownFields[f.GetName()] != nil
+		return descutil.IsListRequestMessage(f.GetOwner()) && knownFields[f.GetName()] != nil
 	},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
 		return knownFields[f.GetName()](f)
@@ -17,7 +17,7 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestFieldTypes(t *testing.T) {
@@ -26,17 +26,17 @@ func TestRequestFieldTypes(t *testing.T) {
 		testName string
 		Message  string
 		Field    string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Filter", "ListBooksRequest", "string filter", nil},
-		{"FilterInvalid", "ListBooksRequest", "bytes filter", testutils.Problems{{Message: "singular string", Suggestion: "string"}}},
-		{"FilterInvalidRepeated", "ListBooksRequest", "repeated string filter", testutils.Problems{{Message: "singular string", Suggestion: "string"}}},
+		{"FilterInvalid", "ListBooksRequest", "bytes filter", ruletest.Problems{{Message: "singular string", Suggestion: "string"}}},
+		{"FilterInvalidRepeated", "ListBooksRequest", "repeated string filter", ruletest.Problems{{Message: "singular string", Suggestion: "string"}}},
 		{"OrderBy", "ListBooksRequest", "string order_by", nil},
-		{"OrderByInvalid", "ListBooksRequest", "bytes order_by", testutils.Problems{{Message: "singular string", Suggestion: "string"}}},
-		{"OrderByInvalidRepeated", "ListBooksRequest", "repeated string order_by", testutils.Problems{{Message: "singular string", Suggestion: "string"}}},
+		{"OrderByInvalid", "ListBooksRequest", "bytes order_by", ruletest.Problems{{Message: "singular string", Suggestion: "string"}}},
+		{"OrderByInvalidRepeated", "ListBooksRequest", "repeated string order_by", ruletest.Problems{{Message: "singular string", Suggestion: "string"}}},
 		{"ShowDeleted", "ListBooksRequest", "bool show_deleted", nil},
-		{"ShowDeletedInvalid", "ListBooksRequest", "int32 show_deleted", testutils.Problems{{Message: "singular bool", Suggestion: "bool"}}},
-		{"ShowDeletedInvalidRepeated", "ListBooksRequest", "repeated bool show_deleted", testutils.Problems{{Message: "singular bool", Suggestion: "bool"}}},
+		{"ShowDeletedInvalid", "ListBooksRequest", "int32 show_deleted", ruletest.Problems{{Message: "singular bool", Suggestion: "bool"}}},
+		{"ShowDeletedInvalidRepeated", "ListBooksRequest", "repeated bool show_deleted", ruletest.Problems{{Message: "singular bool", Suggestion: "bool"}}},
 		{"IrrelevantMessage", "Book", "bytes order_by", nil},
 		{"IrrelevantField", "ListBooksRequest", "bytes foo", nil},
 	}
@@ -44,7 +44,7 @@ func TestRequestFieldTypes(t *testing.T) {
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.Message}} {
 					{{.Field}} = 1;
 				}
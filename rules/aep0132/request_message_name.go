@@ -15,14 +15,15 @@
 package aep0132
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // List messages should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
 	Name:       lint.NewRuleName(132, "request-message-name"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
-	OnlyIf:     utils.IsListMethod,
-	LintMethod: utils.LintMethodHasMatchingRequestName,
+	Metadata:   lint.RuleMetadata{Fixable: true},
+	OnlyIf:     descutil.IsListMethod,
+	LintMethod: descutil.LintMethodHasMatchingRequestName,
 }
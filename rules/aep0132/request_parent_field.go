@@ -15,8 +15,8 @@
 package aep0132
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -25,8 +25,9 @@ import (
 var requestParentField = &lint.FieldRule{
 	Name:     lint.NewRuleName(132, "request-parent-field"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsListRequestMessage(f.GetOwner()) && f.GetName() == "parent"
+		return descutil.IsListRequestMessage(f.GetOwner()) && f.GetName() == "parent"
 	},
-	LintField: utils.LintSingularStringField,
+	LintField: descutil.LintSingularStringField,
 }
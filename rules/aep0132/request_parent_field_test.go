@@ -17,7 +17,7 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestParentField(t *testing.T) {
@@ -27,7 +27,7 @@ func TestRequestParentField(t *testing.T) {
 		MessageName string
 		FieldName   string
 		FieldType   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "ListBooksRequest", "parent", "string", nil},
 		{"IrrelevantMessageName", "EnumerateBooksRequest", "id", "bytes", nil},
@@ -37,13 +37,13 @@ func TestRequestParentField(t *testing.T) {
 			"ListBooksRequest",
 			"parent",
 			"bytes",
-			testutils.Problems{{Suggestion: "string"}},
+			ruletest.Problems{{Suggestion: "string"}},
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					{{.FieldType}} {{.FieldName}} = 1;
 				}
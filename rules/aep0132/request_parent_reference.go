@@ -15,8 +15,8 @@
 package aep0132
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -24,7 +24,7 @@ var requestParentReference = &lint.FieldRule{
 	Name:     lint.NewRuleName(132, "request-parent-reference"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsListRequestMessage(f.GetOwner()) && f.GetName() == "parent"
+		return descutil.IsListRequestMessage(f.GetOwner()) && f.GetName() == "parent"
 	},
-	LintField: utils.LintFieldResourceReference,
+	LintField: descutil.LintFieldResourceReference,
 }
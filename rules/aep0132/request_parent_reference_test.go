@@ -17,19 +17,19 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestParentReference(t *testing.T) {
 	t.Run("Present", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 			message ListBooksRequest {
 				string parent = 1 [(aep.api.field_info).resource_reference = "library.googleapis.com/Publisher"];
 			}
 		`)
-		if diff := (testutils.Problems{}).Diff(requestParentReference.Lint(f)); diff != "" {
+		if diff := (ruletest.Problems{}).Diff(requestParentReference.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
 	})
@@ -37,13 +37,13 @@ func TestRequestParentReference(t *testing.T) {
 		for _, test := range []struct {
 			name      string
 			FieldName string
-			problems  testutils.Problems
+			problems  ruletest.Problems
 		}{
-			{"Error", "parent", testutils.Problems{{Message: "(aep.api.field_info).resource_reference"}}},
-			{"Irrelevant", "something_else", testutils.Problems{}},
+			{"Error", "parent", ruletest.Problems{{Message: "(aep.api.field_info).resource_reference"}}},
+			{"Irrelevant", "something_else", ruletest.Problems{}},
 		} {
 			t.Run(test.name, func(t *testing.T) {
-				f := testutils.ParseProto3Tmpl(t, `
+				f := ruletest.ParseProto3Tmpl(t, `
 					import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 					message ListBooksRequest {
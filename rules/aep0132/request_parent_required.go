@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
@@ -14,7 +14,7 @@ import (
 var requestParentRequired = &lint.MessageRule{
 	Name:     lint.NewRuleName(132, "request-parent-required"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsListRequestMessage,
+	OnlyIf:   descutil.IsListRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		// Rule check: Establish that a `parent` field is present.
 		if m.FindFieldByName("parent") == nil {
@@ -25,9 +25,9 @@ var requestParentRequired = &lint.MessageRule{
 			// from the response, then get the resource annotation from that,
 			// and then inspect the pattern there (oy!).
 			plural := strings.TrimPrefix(strings.TrimSuffix(m.GetName(), "Request"), "List")
-			if resp := utils.FindMessage(m.GetFile(), fmt.Sprintf("List%sResponse", plural)); resp != nil {
+			if resp := descutil.FindMessage(m.GetFile(), fmt.Sprintf("List%sResponse", plural)); resp != nil {
 				if paged := resp.FindFieldByName(strcase.SnakeCase(plural)); paged != nil {
-					if resource := utils.GetResource(paged.GetMessageType()); resource != nil {
+					if resource := descutil.GetResource(paged.GetMessageType()); resource != nil {
 						for _, pattern := range resource.GetPattern() {
 							if strings.Count(pattern, "{") == 1 {
 								return nil
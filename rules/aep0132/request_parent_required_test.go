@@ -3,7 +3,7 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestParentRequired(t *testing.T) {
@@ -11,17 +11,17 @@ func TestRequestParentRequired(t *testing.T) {
 		name        string
 		MessageName string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "ListBooksRequest", "parent", nil},
-		{"InvalidName", "ListBooksRequest", "publisher", testutils.Problems{{Message: "no `parent` field"}}},
+		{"InvalidName", "ListBooksRequest", "publisher", ruletest.Problems{{Message: "no `parent` field"}}},
 		{"Irrelevant", "EnumerateBooksRequest", "id", nil},
 		{"IrrelevantAEP162", "ListBookRevisionsRequest", "name", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					string {{.FieldName}} = 1;
 				}
@@ -43,7 +43,7 @@ func TestRequestParentRequired(t *testing.T) {
 		{"ValidTopLevelWithPackage", "package foo;"},
 	} {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				{{.Package}}
 				import "aep/api/resource.proto";
 				message ListBooksRequest {}
@@ -58,7 +58,7 @@ func TestRequestParentRequired(t *testing.T) {
 				}
 			`, test)
 			problems := requestParentRequired.Lint(f)
-			if diff := (testutils.Problems{}).Diff(problems); diff != "" {
+			if diff := (ruletest.Problems{}).Diff(problems); diff != "" {
 				t.Error(diff)
 			}
 		})
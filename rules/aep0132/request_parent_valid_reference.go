@@ -18,9 +18,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -28,17 +28,17 @@ var requestParentValidReference = &lint.FieldRule{
 	Name:     lint.NewRuleName(132, "request-parent-valid-reference"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		ref := utils.GetResourceReference(f)
+		ref := descutil.GetResourceReference(f)
 		types := ref.GetType()
-		return utils.IsListRequestMessage(f.GetOwner()) && f.GetName() == "parent" && ref != nil && len(types) > 0
+		return descutil.IsListRequestMessage(f.GetOwner()) && f.GetName() == "parent" && ref != nil && len(types) > 0
 	},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
 		p := f.GetParent()
 		msg, _ := p.(*desc.MessageDescriptor)
-		types := utils.GetResourceReference(f).GetType()
+		types := descutil.GetResourceReference(f).GetType()
 		res := types[0]
 
-		response := utils.FindMessage(f.GetFile(), strings.Replace(msg.GetName(), "Request", "Response", 1))
+		response := descutil.FindMessage(f.GetFile(), strings.Replace(msg.GetName(), "Request", "Response", 1))
 		if response == nil {
 			return nil
 		}
@@ -49,7 +49,7 @@ var requestParentValidReference = &lint.FieldRule{
 				continue
 			}
 
-			if r := utils.GetResource(typ); r != nil && r.GetType() == res {
+			if r := descutil.GetResource(typ); r != nil && r.GetType() == res {
 				return []lint.Problem{{
 					Message:    fmt.Sprintf("The `(aep.api.field_info).resource_reference` on `%s` field should reference the parent(s) of `%s`.", f.GetName(), res),
 					Descriptor: f,
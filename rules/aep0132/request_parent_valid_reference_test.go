@@ -17,19 +17,19 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestParentValidReference(t *testing.T) {
 	for _, test := range []struct {
 		name          string
 		ReferenceType string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
-		{"Valid", "library.googleapis.com/Publisher", testutils.Problems{}},
-		{"Invalid", "library.googleapis.com/Book", testutils.Problems{{Message: "reference the parent(s)"}}},
+		{"Valid", "library.googleapis.com/Publisher", ruletest.Problems{}},
+		{"Invalid", "library.googleapis.com/Book", ruletest.Problems{{Message: "reference the parent(s)"}}},
 	} {
-		f := testutils.ParseProto3Tmpl(t, `
+		f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 			message ListBooksRequest {
@@ -18,8 +18,8 @@ import (
 	"fmt"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,13 +27,13 @@ import (
 var requestRequiredFields = &lint.MessageRule{
 	Name:     lint.NewRuleName(132, "request-required-fields"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsListRequestMessage,
+	OnlyIf:   descutil.IsListRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that there are no unexpected fields.
 		allowedRequiredFields := stringset.New("parent")
 
 		for _, f := range m.GetFields() {
-			if !utils.GetFieldBehavior(f).Contains("REQUIRED") {
+			if !descutil.GetFieldBehavior(f).Contains("REQUIRED") {
 				continue
 			}
 			// add a problem.
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
@@ -16,16 +16,16 @@ var requestShowDeletedRequired = &lint.MessageRule{
 	Name:     lint.NewRuleName(132, "request-show-deleted-required"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
 	OnlyIf: func(m *desc.MessageDescriptor) bool {
-		if !utils.IsListRequestMessage(m) {
+		if !descutil.IsListRequestMessage(m) {
 			return false
 		}
 		// Check for soft-delete support by getting the resource name
 		// from the corresponding response message.
 		plural := strings.TrimPrefix(strings.TrimSuffix(m.GetName(), "Request"), "List")
-		if resp := utils.FindMessage(m.GetFile(), fmt.Sprintf("List%sResponse", plural)); resp != nil {
+		if resp := descutil.FindMessage(m.GetFile(), fmt.Sprintf("List%sResponse", plural)); resp != nil {
 			if paged := resp.FindFieldByName(strcase.SnakeCase(plural)); paged != nil && paged.GetMessageType() != nil {
 				singular := paged.GetMessageType().GetName()
-				return utils.FindMethod(m.GetFile(), "Undelete"+singular) != nil
+				return descutil.FindMethod(m.GetFile(), "Undelete"+singular) != nil
 			}
 		}
 		return false
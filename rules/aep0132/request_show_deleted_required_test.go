@@ -3,7 +3,7 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestShowDeletedRequired(t *testing.T) {
@@ -12,17 +12,17 @@ func TestRequestShowDeletedRequired(t *testing.T) {
 		Message  string
 		Method   string
 		Field    string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "ListBooksRequest", "UndeleteBook", `bool show_deleted = 1;`, nil},
-		{"Invalid", "ListBooksRequest", "UndeleteBook", ``, testutils.Problems{{Message: "show_deleted"}}},
+		{"Invalid", "ListBooksRequest", "UndeleteBook", ``, ruletest.Problems{{Message: "show_deleted"}}},
 		{"IrrelevantNoSoftDelete", "ListBooksRequest", "GetBook", ``, nil},
 		{"IrrelevantMessage", "EnumerateBooksRequest", "UndeleteBook", "", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.Method}}({{.Method}}Request) returns (Book);
 				}
@@ -45,14 +45,14 @@ func TestRequestShowDeletedRequired(t *testing.T) {
 
 // Regression test for https://github.com/aep-dev/api-linter/issues/854.
 func TestRequestShowDeletedRequired_NonMessageType(t *testing.T) {
-	f := testutils.ParseProto3Tmpl(t, `
+	f := ruletest.ParseProto3Tmpl(t, `
 		message ListBooksRequest {}
 		message ListBooksResponse {
 			repeated string books = 1;
 		}
 	`, nil)
 	problems := requestShowDeletedRequired.Lint(f)
-	if diff := (testutils.Problems{}).SetDescriptor(f.GetMessageTypes()[0]).Diff(problems); diff != "" {
+	if diff := (ruletest.Problems{}).SetDescriptor(f.GetMessageTypes()[0]).Diff(problems); diff != "" {
 		t.Error(diff)
 	}
 }
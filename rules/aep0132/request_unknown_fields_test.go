@@ -17,7 +17,7 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 	fpb "google.golang.org/genproto/protobuf/field_mask"
@@ -36,20 +36,20 @@ func TestUnknownFields(t *testing.T) {
 		messageName string
 		fieldName   string
 		fieldType   *builder.FieldType
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
-		{"PageSize", "ListBooksRequest", "max_page_size", builder.FieldTypeInt32(), testutils.Problems{}},
-		{"PageToken", "ListBooksRequest", "page_token", builder.FieldTypeString(), testutils.Problems{}},
-		{"Skip", "ListBooksRequest", "skip", builder.FieldTypeInt32(), testutils.Problems{}},
-		{"Filter", "ListBooksRequest", "filter", builder.FieldTypeString(), testutils.Problems{}},
-		{"OrderBy", "ListBooksRequest", "order_by", builder.FieldTypeString(), testutils.Problems{}},
-		{"ShowDeleted", "ListBooksRequest", "show_deleted", builder.FieldTypeBool(), testutils.Problems{}},
-		{"RequestId", "ListBooksRequest", "request_id", builder.FieldTypeImportedMessage(fieldMask), testutils.Problems{}},
-		{"ReadMask", "ListBooksRequest", "read_mask", builder.FieldTypeImportedMessage(fieldMask), testutils.Problems{}},
-		{"View", "ListBooksRequest", "view", builder.FieldTypeEnum(builder.NewEnum("View").AddValue(builder.NewEnumValue("BASIC"))), testutils.Problems{}},
-		{"Invalid", "ListBooksRequest", "application_id", builder.FieldTypeString(), testutils.Problems{{Message: "explicitly described"}}},
-		{"Irrelevant", "EnumerteBooksRequest", "application_id", builder.FieldTypeString(), testutils.Problems{}},
-		{"IrrelevantAEP162", "ListBookRevisionsRequest", "name", builder.FieldTypeString(), testutils.Problems{}},
+		{"PageSize", "ListBooksRequest", "max_page_size", builder.FieldTypeInt32(), ruletest.Problems{}},
+		{"PageToken", "ListBooksRequest", "page_token", builder.FieldTypeString(), ruletest.Problems{}},
+		{"Skip", "ListBooksRequest", "skip", builder.FieldTypeInt32(), ruletest.Problems{}},
+		{"Filter", "ListBooksRequest", "filter", builder.FieldTypeString(), ruletest.Problems{}},
+		{"OrderBy", "ListBooksRequest", "order_by", builder.FieldTypeString(), ruletest.Problems{}},
+		{"ShowDeleted", "ListBooksRequest", "show_deleted", builder.FieldTypeBool(), ruletest.Problems{}},
+		{"RequestId", "ListBooksRequest", "request_id", builder.FieldTypeImportedMessage(fieldMask), ruletest.Problems{}},
+		{"ReadMask", "ListBooksRequest", "read_mask", builder.FieldTypeImportedMessage(fieldMask), ruletest.Problems{}},
+		{"View", "ListBooksRequest", "view", builder.FieldTypeEnum(builder.NewEnum("View").AddValue(builder.NewEnumValue("BASIC"))), ruletest.Problems{}},
+		{"Invalid", "ListBooksRequest", "application_id", builder.FieldTypeString(), ruletest.Problems{{Message: "explicitly described"}}},
+		{"Irrelevant", "EnumerteBooksRequest", "application_id", builder.FieldTypeString(), ruletest.Problems{}},
+		{"IrrelevantAEP162", "ListBookRevisionsRequest", "name", builder.FieldTypeString(), ruletest.Problems{}},
 	}
 
 	// Run each test individually.
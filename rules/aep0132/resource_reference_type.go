@@ -16,9 +16,9 @@ package aep0132
 
 import (
 	aepapi "buf.build/gen/go/aep/api/protocolbuffers/go/aep/api"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -31,19 +31,19 @@ var resourceReferenceType = &lint.MethodRule{
 		p := m.GetInputType().FindFieldByName("parent")
 
 		var resource *aepapi.ResourceDescriptor
-		resourceField := utils.GetListResourceMessage(m)
+		resourceField := descutil.GetListResourceMessage(m)
 		if resourceField != nil {
-			resource = utils.GetResource(resourceField)
+			resource = descutil.GetResource(resourceField)
 		}
-		return utils.IsListMethod(m) && p != nil && utils.GetResourceReference(p) != nil && resource != nil
+		return descutil.IsListMethod(m) && p != nil && descutil.GetResourceReference(p) != nil && resource != nil
 	},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		// The first repeated message field must be the paginated resource.
-		repeated := utils.GetRepeatedMessageFields(m.GetOutputType())
-		resource := utils.GetResource(repeated[0].GetMessageType())
+		repeated := descutil.GetRepeatedMessageFields(m.GetOutputType())
+		resource := descutil.GetResource(repeated[0].GetMessageType())
 
 		parent := m.GetInputType().FindFieldByName("parent")
-		ref := utils.GetResourceReference(parent)
+		ref := descutil.GetResourceReference(parent)
 
 		// Check resource reference matches the child resource type.
 		// In AEP format, use resource_reference_child_type to reference the child resource.
@@ -17,7 +17,7 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceReferenceType(t *testing.T) {
@@ -33,19 +33,19 @@ option (aep.api.resource) = {
 		testName           string
 		Annotation         string
 		ResourceAnnotation string
-		problems           testutils.Problems
+		problems           ruletest.Problems
 	}{
 		{"ValidMatch_resource_reference", `resource_reference = "library.googleapis.com/Book"`, bookResource, nil},
-		{"InvalidMismatch_resource_reference", `resource_reference = "library.googleapis.com/Shelf"`, bookResource, testutils.Problems{{Message: "`resource_reference_child_type`"}}},
+		{"InvalidMismatch_resource_reference", `resource_reference = "library.googleapis.com/Shelf"`, bookResource, ruletest.Problems{{Message: "`resource_reference_child_type`"}}},
 		{"ValidMatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Book"`, bookResource, nil},
-		{"InvalidMismatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Shelf"`, bookResource, testutils.Problems{{Message: "`resource_reference_child_type`"}}},
+		{"InvalidMismatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Shelf"`, bookResource, ruletest.Problems{{Message: "`resource_reference_child_type`"}}},
 		{"SkipNoResource", `resource_reference = "library.googleapis.com/Book"`, "", nil},
 	}
 
 	// Run each test.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 				service Library {
@@ -15,14 +15,15 @@
 package aep0132
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // List messages should use a `ListFoosResponse` response message.
 var responseMessageName = &lint.MethodRule{
 	Name:       lint.NewRuleName(132, "response-message-name"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
-	OnlyIf:     utils.IsListMethod,
-	LintMethod: utils.LintMethodHasMatchingResponseName,
+	Metadata:   lint.RuleMetadata{Fixable: true},
+	OnlyIf:     descutil.IsListMethod,
+	LintMethod: descutil.LintMethodHasMatchingResponseName,
 }
@@ -16,8 +16,8 @@ package aep0132
 
 import (
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -36,7 +36,7 @@ var responseUnknownFields = &lint.FieldRule{
 	Name:     lint.NewRuleName(132, "response-unknown-fields"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsListResponseMessage(f.GetOwner())
+		return descutil.IsListResponseMessage(f.GetOwner())
 	},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
 		if !respAllowedFields.Contains(f.GetName()) {
@@ -17,7 +17,7 @@ package aep0132
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/stoewer/go-strcase"
 )
 
@@ -25,7 +25,7 @@ func TestResponseUnknownFields(t *testing.T) {
 	for _, test := range []struct {
 		MessageName string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"ListBooksResponse", "total_size", nil},
 		{"ListBooksResponse", "results", nil},
@@ -33,11 +33,11 @@ func TestResponseUnknownFields(t *testing.T) {
 		{"ListBooksResponse", "unreachable", nil},
 		{"ListBooksResponse", "unreachable_locations", nil},
 		{"ListBookRevisionsResponse", "total_size", nil},
-		{"ListBooksResponse", "extra", testutils.Problems{{Message: "List responses"}}},
-		{"ListBooksResponse", "books", testutils.Problems{{Message: "List responses"}}},
+		{"ListBooksResponse", "extra", ruletest.Problems{{Message: "List responses"}}},
+		{"ListBooksResponse", "books", ruletest.Problems{{Message: "List responses"}}},
 	} {
 		t.Run(strcase.UpperCamelCase(test.FieldName), func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					string next_page_token = 1;
 					string {{.FieldName}} = 2;
@@ -18,19 +18,19 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 // Create methods should have an HTTP body, and the body value should be resource.
 var httpBody = &lint.MethodRule{
 	Name:     lint.NewRuleName(133, "http-body"),
-	OnlyIf:   utils.IsCreateMethod,
+	OnlyIf:   descutil.IsCreateMethod,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		resourceMsgName := utils.GetResourceMessageName(m, "Create")
+		resourceMsgName := descutil.GetResourceMessageName(m, "Create")
 		resourceFieldName := strings.ToLower(resourceMsgName)
 		for _, fieldDesc := range m.GetInputType().GetFields() {
 			// when msgDesc is nil, the resource field in the request message is
@@ -42,7 +42,7 @@ var httpBody = &lint.MethodRule{
 		}
 
 		// Establish that HTTP body the RPC should map the resource field name in the request message.
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for _, httpRule := range descutil.GetHTTPRules(m) {
 			if httpRule.Body == "" {
 				// Establish that the RPC should have HTTP body
 				return []lint.Problem{{
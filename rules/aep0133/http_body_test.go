@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpBody(t *testing.T) {
@@ -26,19 +26,19 @@ func TestHttpBody(t *testing.T) {
 		ResourceField string
 		Body          string
 		MethodName    string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
 		{"Valid", "Book book = 1;", "book", "CreateBook", nil},
 		{"Valid", "Book textbook = 1;", "textbook", "CreateBook", nil},
 		{"Valid", "", "book", "CreateBook", nil}, // valid for http body rule check, but it will fail under resource fail rule check
-		{"Invalid_BodyMissing", "Book book = 1;", "", "CreateBook", testutils.Problems{{Message: "Post methods should have an HTTP body"}}},
-		{"Invalid_BodyMismatch", "Book book = 1;", "abook", "CreateBook", testutils.Problems{{Message: `The content of body "abook" must map to the resource field "book" in the request message`}}},
+		{"Invalid_BodyMissing", "Book book = 1;", "", "CreateBook", ruletest.Problems{{Message: "Post methods should have an HTTP body"}}},
+		{"Invalid_BodyMismatch", "Book book = 1;", "abook", "CreateBook", ruletest.Problems{{Message: `The content of body "abook" must map to the resource field "book" in the request message`}}},
 		{"Irrelevant", "Book book = 1;", "book", "CreateBook", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
@@ -15,14 +15,14 @@
 package aep0133
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Create methods should use the HTTP POST verb.
 var httpMethod = &lint.MethodRule{
 	Name:       lint.NewRuleName(133, "http-method"),
-	OnlyIf:     utils.IsCreateMethod,
-	LintMethod: utils.LintHTTPMethod("POST"),
+	OnlyIf:     descutil.IsCreateMethod,
+	LintMethod: descutil.LintHTTPMethod("POST"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
 }
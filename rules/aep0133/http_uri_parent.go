@@ -15,8 +15,8 @@
 package aep0133
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -31,8 +31,8 @@ var httpURIParent = &lint.MethodRule{
 		// response_type must be the resource.
 		res := m.GetOutputType()
 
-		if info := utils.GetOperationInfo(m); info != nil {
-			res = utils.FindMessage(m.GetFile(), info.GetResponseType())
+		if info := descutil.GetOperationInfo(m); info != nil {
+			res = descutil.FindMessage(m.GetFile(), info.GetResponseType())
 			// If we cannot resolve the response_type, then skip this check.
 			// There is nothing we can do, and must let the check specific to
 			// unresolvable operation_info types do its thing.
@@ -41,13 +41,13 @@ var httpURIParent = &lint.MethodRule{
 			}
 		}
 
-		return utils.IsCreateMethod(m) && !hasNoParent(res)
+		return descutil.IsCreateMethod(m) && !hasNoParent(res)
 	},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		if problems := utils.LintHTTPURIHasParentVariable(m); problems != nil {
+		if problems := descutil.LintHTTPURIHasParentVariable(m); problems != nil {
 			return problems
 		}
-		if problems := utils.LintHTTPURIVariableCount(m, 1); problems != nil {
+		if problems := descutil.LintHTTPURIVariableCount(m, 1); problems != nil {
 			return problems
 		}
 		return nil
@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHTTPURIParent(t *testing.T) {
@@ -26,19 +26,19 @@ func TestHTTPURIParent(t *testing.T) {
 		URI        string
 		MethodName string
 		Pattern    string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", "/v1/{parent=publishers/*/books/*}", "CreateBook", "publishers/{publisher}/books/{book}", nil},
-		{"InvalidVarParent", "/v1/{book=publishers/*/books/*}", "CreateBook", "publishers/{publisher}/books/{book}", testutils.Problems{{Message: "`parent` variable"}}},
-		{"NoVarParent", "/v1/publishers/*/books/*", "CreateBook", "publishers/{publisher}/books/{book}", testutils.Problems{{Message: "`parent` variable"}}},
+		{"InvalidVarParent", "/v1/{book=publishers/*/books/*}", "CreateBook", "publishers/{publisher}/books/{book}", ruletest.Problems{{Message: "`parent` variable"}}},
+		{"NoVarParent", "/v1/publishers/*/books/*", "CreateBook", "publishers/{publisher}/books/{book}", ruletest.Problems{{Message: "`parent` variable"}}},
 		{"NoParent", "/v1/books/*", "CreateBook", "books/{book}", nil},
-		{"MultipleVars", "/v1/{parent=publishers/*}/{book=books/*}", "CreateBook", "publishers/{publisher}/books/{book}", testutils.Problems{{Message: "1 variable"}}},
+		{"MultipleVars", "/v1/{parent=publishers/*}/{book=books/*}", "CreateBook", "publishers/{publisher}/books/{book}", ruletest.Problems{{Message: "1 variable"}}},
 		{"Irrelevant", "/v1/{book=publishers/*/books/*}", "BuildBook", "publishers/{publisher}/books/{book}", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/resource.proto";
 				service Library {
@@ -61,7 +61,7 @@ func TestHTTPURIParent(t *testing.T) {
 			}
 		})
 		t.Run(test.testName+"/Operation", func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/resource.proto";
 				import "google/longrunning/operations.proto";
@@ -91,7 +91,7 @@ func TestHTTPURIParent(t *testing.T) {
 	}
 
 	t.Run("AdditionalBinding", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "google/api/annotations.proto";
 			import "aep/api/resource.proto";
 			service Library {
@@ -114,7 +114,7 @@ func TestHTTPURIParent(t *testing.T) {
 			}
 		`)
 		method := f.GetServices()[0].GetMethods()[0]
-		problems := testutils.Problems{}
+		problems := ruletest.Problems{}
 		if diff := problems.SetDescriptor(method).Diff(httpURIParent.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
@@ -18,8 +18,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -29,13 +29,13 @@ var httpURIResource = &lint.MethodRule{
 	Name:     lint.NewRuleName(133, "http-uri-resource"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(m *desc.MethodDescriptor) bool {
-		return utils.IsCreateMethod(m) && len(utils.GetHTTPRules(m)) > 0
+		return descutil.IsCreateMethod(m) && len(descutil.GetHTTPRules(m)) > 0
 	},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		problems := []lint.Problem{}
 
 		// Extract the suffix of the URI path as the collection identifier.
-		uriParts := strings.Split(utils.GetHTTPRules(m)[0].URI, "/")
+		uriParts := strings.Split(descutil.GetHTTPRules(m)[0].URI, "/")
 		collectionName := uriParts[len(uriParts)-1]
 		// Custom Method Standard Create lookalikes can still be linted, but
 		// don't include the custom method http suffix
@@ -55,7 +55,7 @@ var httpURIResource = &lint.MethodRule{
 		// Go through each pattern in the resource and make sure it contains the
 		// collection identifier.
 		collectionName += "/"
-		resource := utils.GetResource(m.GetOutputType())
+		resource := descutil.GetResource(m.GetOutputType())
 		for _, pattern := range resource.GetPattern() {
 			if !strings.Contains(pattern, collectionName) {
 				problems = append(problems, lint.Problem{
@@ -18,7 +18,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,19 +27,19 @@ func TestHTTPURIResource(t *testing.T) {
 		testName string
 		URI      string
 		Pattern  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "/v1/{parent=publishers/*}/books", "publishers/{publisher}/books/{book}", nil},
 		{"ValidCustomLookalike", "/v1/{parent=publishers/*}/books:createAndCheckout", "publishers/{publisher}/books/{book}", nil},
 		{"MethodMissingURIPath", "", "publishers/{publisher}/books/{book}", nil},
-		{"MethodMissingCollectionURISuffix", "/v1/", "publishers/{publisher}/books/{book}", testutils.Problems{{Message: "The URI path does not end in a collection identifier."}}},
-		{"ResourceMissingCollectionInPattern", "/v1/{parent=publishers/*}/books", "publishers/{publisher}", testutils.Problems{{Message: "Resource pattern should contain the collection identifier \"books/\"."}}},
-		{"ResourceMissingCollectionCustomLookalike", "/v1/{parent=publishers/*}/books:createAndCheckout", "publishers/{publisher}", testutils.Problems{{Message: "Resource pattern should contain the collection identifier \"books/\"."}}},
+		{"MethodMissingCollectionURISuffix", "/v1/", "publishers/{publisher}/books/{book}", ruletest.Problems{{Message: "The URI path does not end in a collection identifier."}}},
+		{"ResourceMissingCollectionInPattern", "/v1/{parent=publishers/*}/books", "publishers/{publisher}", ruletest.Problems{{Message: "Resource pattern should contain the collection identifier \"books/\"."}}},
+		{"ResourceMissingCollectionCustomLookalike", "/v1/{parent=publishers/*}/books:createAndCheckout", "publishers/{publisher}", ruletest.Problems{{Message: "Resource pattern should contain the collection identifier \"books/\"."}}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/resource.proto";
 				service Library {
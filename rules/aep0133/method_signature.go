@@ -19,23 +19,24 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
 
 var methodSignature = &lint.MethodRule{
 	Name:     lint.NewRuleName(133, "method-signature"),
-	OnlyIf:   utils.IsCreateMethod,
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsCreateMethod,
 	RuleType: lint.NewRuleType(lint.ShouldRule),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		signatures := utils.GetMethodSignatures(m)
+		signatures := descutil.GetMethodSignatures(m)
 
 		// Determine what signature we want. The {resource}_id is desired
 		// if and only if the field exists on the request.
-		resourceField := strcase.SnakeCase(utils.GetResourceMessageName(m, "Create"))
+		resourceField := strcase.SnakeCase(descutil.GetResourceMessageName(m, "Create"))
 		want := []string{}
 		if !hasNoParent(m.GetOutputType()) {
 			want = append(want, "parent")
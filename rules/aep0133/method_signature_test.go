@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestMethodSignature(t *testing.T) {
@@ -26,44 +26,44 @@ func TestMethodSignature(t *testing.T) {
 		MethodName string
 		Signature  string
 		IDField    string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"ValidNoID", "CreateBook", `option (google.api.method_signature) = "parent,book";`, "", testutils.Problems{}},
-		{"ValidID", "CreateBook", `option (google.api.method_signature) = "parent,book,book_id";`, "string book_id = 3;", testutils.Problems{}},
-		{"ValidOperation", "CreateUnitOperation", `option (google.api.method_signature) = "parent,unit_operation,unit_operation_id";`, "string unit_operation_id = 3;", testutils.Problems{}},
-		{"MissingNoID", "CreateBook", "", "", testutils.Problems{{Message: `(google.api.method_signature) = "parent,book"`}}},
+		{"ValidNoID", "CreateBook", `option (google.api.method_signature) = "parent,book";`, "", ruletest.Problems{}},
+		{"ValidID", "CreateBook", `option (google.api.method_signature) = "parent,book,book_id";`, "string book_id = 3;", ruletest.Problems{}},
+		{"ValidOperation", "CreateUnitOperation", `option (google.api.method_signature) = "parent,unit_operation,unit_operation_id";`, "string unit_operation_id = 3;", ruletest.Problems{}},
+		{"MissingNoID", "CreateBook", "", "", ruletest.Problems{{Message: `(google.api.method_signature) = "parent,book"`}}},
 		{
 			"MissingID",
 			"CreateBook",
 			"",
 			"string book_id = 3;",
-			testutils.Problems{{Message: `(google.api.method_signature) = "parent,book,book_id"`}},
+			ruletest.Problems{{Message: `(google.api.method_signature) = "parent,book,book_id"`}},
 		},
 		{
 			"Wrong",
 			"CreateBook",
 			`option (google.api.method_signature) = "publisher,book";`,
 			"",
-			testutils.Problems{{Suggestion: `option (google.api.method_signature) = "parent,book";`}},
+			ruletest.Problems{{Suggestion: `option (google.api.method_signature) = "parent,book";`}},
 		},
 		{
 			"WrongIDMissing",
 			"CreateBook",
 			`option (google.api.method_signature) = "parent,book";`,
 			"string book_id = 3;",
-			testutils.Problems{{Suggestion: `option (google.api.method_signature) = "parent,book,book_id";`}},
+			ruletest.Problems{{Suggestion: `option (google.api.method_signature) = "parent,book,book_id";`}},
 		},
 		{
 			"WrongIDPresent",
 			"CreateBook",
 			`option (google.api.method_signature) = "parent,book,book_id";`,
 			"",
-			testutils.Problems{{Suggestion: `option (google.api.method_signature) = "parent,book";`}},
+			ruletest.Problems{{Suggestion: `option (google.api.method_signature) = "parent,book";`}},
 		},
-		{"Irrelevant", "WriteBook", "", "", testutils.Problems{}},
+		{"Irrelevant", "WriteBook", "", "", ruletest.Problems{}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/client.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
@@ -87,7 +87,7 @@ func TestMethodSignature(t *testing.T) {
 	// Add a separate test for the no-parent case rather than introducing yet
 	// another knob on the above test.
 	t.Run("NoParent", func(t *testing.T) {
-		file := testutils.ParseProto3String(t, `
+		file := ruletest.ParseProto3String(t, `
 			import "google/api/client.proto";
 			import "aep/api/resource.proto";
 			service Library {
@@ -105,7 +105,7 @@ func TestMethodSignature(t *testing.T) {
 				};
 			}
 		`)
-		if diff := (testutils.Problems{}).Diff(methodSignature.Lint(file)); diff != "" {
+		if diff := (ruletest.Problems{}).Diff(methodSignature.Lint(file)); diff != "" {
 			t.Error(diff)
 		}
 	})
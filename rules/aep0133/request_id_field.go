@@ -17,18 +17,18 @@ package aep0133
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestIDField = &lint.MessageRule{
 	Name:     lint.NewRuleName(133, "request-id-field"),
-	OnlyIf:   utils.IsCreateRequestMessage,
+	OnlyIf:   descutil.IsCreateRequestMessage,
 	RuleType: lint.NewRuleType(lint.ShouldRule),
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		idField := "id"
-		if field := m.FindFieldByName(idField); field == nil || utils.GetTypeName(field) != "string" || field.IsRepeated() {
+		if field := m.FindFieldByName(idField); field == nil || descutil.GetTypeName(field) != "string" || field.IsRepeated() {
 			return []lint.Problem{{
 				Message:    fmt.Sprintf("create methods should contain a singular `string %s` field.", idField),
 				Descriptor: m,
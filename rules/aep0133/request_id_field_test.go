@@ -17,15 +17,15 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestIDField(t *testing.T) {
-	problems := testutils.Problems{{Message: "`string id`"}}
+	problems := ruletest.Problems{{Message: "`string id`"}}
 	for _, test := range []struct {
 		name     string
 		IDField  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "string id = 2;", nil},
 		{"InvalidMissing", "", problems},
@@ -34,7 +34,7 @@ func TestRequestIDField(t *testing.T) {
 		{"InvalidRepeated", "repeated string id = 2;", problems},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 
 				service Library {
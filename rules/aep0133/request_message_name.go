@@ -15,14 +15,15 @@
 package aep0133
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Create method should have a properly named input message.
 var inputName = &lint.MethodRule{
 	Name:       lint.NewRuleName(133, "request-message-name"),
-	OnlyIf:     utils.IsCreateMethod,
-	LintMethod: utils.LintMethodHasMatchingRequestName,
+	Metadata:   lint.RuleMetadata{Fixable: true},
+	OnlyIf:     descutil.IsCreateMethod,
+	LintMethod: descutil.LintMethodHasMatchingRequestName,
 	RuleType:   lint.NewRuleType(lint.MustRule),
 }
@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestInputName(t *testing.T) {
@@ -26,12 +26,12 @@ func TestInputName(t *testing.T) {
 		testName   string
 		MethodName string
 		InputName  string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", "CreateBook", "CreateBookRequest", nil},
 		{
 			"Invalid", "CreateBook", "Book",
-			testutils.Problems{{
+			ruletest.Problems{{
 				Suggestion: "CreateBookRequest",
 			}},
 		},
@@ -42,7 +42,7 @@ func TestInputName(t *testing.T) {
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.InputName}}) returns (Book) {}
 				}
@@ -15,16 +15,16 @@
 package aep0133
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestParentBehavior = &lint.FieldRule{
 	Name: lint.NewRuleName(133, "request-parent-behavior"),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsCreateRequestMessage(f.GetOwner()) && f.GetName() == "parent"
+		return descutil.IsCreateRequestMessage(f.GetOwner()) && f.GetName() == "parent"
 	},
-	LintField: utils.LintRequiredField,
+	LintField: descutil.LintRequiredField,
 	RuleType:  lint.NewRuleType(lint.ShouldRule),
 }
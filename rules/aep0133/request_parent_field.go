@@ -15,17 +15,18 @@
 package aep0133
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 // The type of the parent field in a create request should be string.
 var requestParentField = &lint.FieldRule{
-	Name: lint.NewRuleName(133, "request-parent-field"),
+	Name:     lint.NewRuleName(133, "request-parent-field"),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsCreateRequestMessage(f.GetOwner()) && f.GetName() == "parent"
+		return descutil.IsCreateRequestMessage(f.GetOwner()) && f.GetName() == "parent"
 	},
-	LintField: utils.LintSingularStringField,
+	LintField: descutil.LintSingularStringField,
 	RuleType:  lint.NewRuleType(lint.MustRule),
 }
@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestParentField(t *testing.T) {
@@ -27,10 +27,10 @@ func TestRequestParentField(t *testing.T) {
 		MessageName string
 		FieldName   string
 		FieldType   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "CreateBookRequest", "parent", "string", nil},
-		{"InvalidType", "CreateBookRequest", "parent", "bytes", testutils.Problems{{Suggestion: "string"}}},
+		{"InvalidType", "CreateBookRequest", "parent", "bytes", ruletest.Problems{{Suggestion: "string"}}},
 		{"IrrelevantMessage", "AddBookRequest", "parent", "bytes", nil},
 		{"IrrelevantField", "CreateBookRequest", "id", "bytes", nil},
 	}
@@ -38,7 +38,7 @@ func TestRequestParentField(t *testing.T) {
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					{{.FieldType}} {{.FieldName}} = 1;
 				}
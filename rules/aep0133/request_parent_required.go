@@ -4,15 +4,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
 
 var requestParentRequired = &lint.MessageRule{
 	Name:   lint.NewRuleName(133, "request-parent-required"),
-	OnlyIf: utils.IsCreateRequestMessage,
+	OnlyIf: descutil.IsCreateRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		if m.FindFieldByName("parent") == nil {
 			// Sanity check: If the resource has a pattern, and that pattern
@@ -41,7 +41,7 @@ var requestParentRequired = &lint.MessageRule{
 }
 
 func hasNoParent(m *desc.MessageDescriptor) bool {
-	if resource := utils.GetResource(m); resource != nil {
+	if resource := descutil.GetResource(m); resource != nil {
 		for _, pattern := range resource.GetPattern() {
 			if strings.Count(pattern, "{") == 1 {
 				return true
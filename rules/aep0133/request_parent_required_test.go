@@ -3,7 +3,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestParentFieldRequired(t *testing.T) {
@@ -12,17 +12,17 @@ func TestRequestParentFieldRequired(t *testing.T) {
 		name        string
 		MessageName string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "CreateBookRequest", "parent", nil},
-		{"MissingParent", "CreateBookRequest", "id", testutils.Problems{{Message: "parent"}}},
+		{"MissingParent", "CreateBookRequest", "id", ruletest.Problems{{Message: "parent"}}},
 		{"IrrelevantMessage", "AddBookRequest", "id", nil},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					string {{.FieldName}} = 1;
 				}
@@ -39,7 +39,7 @@ func TestRequestParentFieldRequired(t *testing.T) {
 	// Test the "top-level exception", which is more involved
 	// than the other tests and therefore handled separately.
 	t.Run("ValidTopLevel", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
 			message CreateBookRequest {
 				Book book = 2;
@@ -52,7 +52,7 @@ func TestRequestParentFieldRequired(t *testing.T) {
 			}
 		`)
 		problems := requestParentRequired.Lint(f)
-		if diff := (testutils.Problems{}).Diff(problems); diff != "" {
+		if diff := (ruletest.Problems{}).Diff(problems); diff != "" {
 			t.Error(diff)
 		}
 	})
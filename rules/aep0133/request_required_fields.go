@@ -18,18 +18,18 @@ import (
 	"fmt"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 // The create request message should not have unrecognized fields.
 var requestRequiredFields = &lint.MethodRule{
 	Name:     lint.NewRuleName(133, "request-required-fields"),
-	OnlyIf:   utils.IsCreateMethod,
+	OnlyIf:   descutil.IsCreateMethod,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		ot := utils.GetResponseType(m)
+		ot := descutil.GetResponseType(m)
 
 		// Rule check: Establish that there are no unexpected fields.
 		allowedRequiredFields := stringset.New(
@@ -39,7 +39,7 @@ var requestRequiredFields = &lint.MethodRule{
 
 		problems := []lint.Problem{}
 		for _, f := range m.GetInputType().GetFields() {
-			if !utils.GetFieldBehavior(f).Contains("REQUIRED") {
+			if !descutil.GetFieldBehavior(f).Contains("REQUIRED") {
 				continue
 			}
 			// Skip the check with the field that is the resource, which for
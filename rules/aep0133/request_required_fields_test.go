@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,7 +27,7 @@ func TestRequiredFieldTests(t *testing.T) {
 		Fields               string
 		problematicFieldName string
 		Singular             string
-		problems             testutils.Problems
+		problems             ruletest.Problems
 	}{
 		{
 			"ValidNoExtraFields",
@@ -62,7 +62,7 @@ func TestRequiredFieldTests(t *testing.T) {
 			"bool validate_only = 3 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];",
 			"validate_only",
 			"",
-			testutils.Problems{
+			ruletest.Problems{
 				{Message: `Create RPCs must only require fields explicitly described in AEPs, not "validate_only"`},
 			},
 		},
@@ -71,7 +71,7 @@ func TestRequiredFieldTests(t *testing.T) {
 			"bool create_iam = 3 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];",
 			"create_iam",
 			"",
-			testutils.Problems{
+			ruletest.Problems{
 				{Message: `Create RPCs must only require fields explicitly described in AEPs, not "create_iam"`},
 			},
 		},
@@ -80,13 +80,13 @@ func TestRequiredFieldTests(t *testing.T) {
 			"Foo foo = 3 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];",
 			"foo",
 			"",
-			testutils.Problems{
+			ruletest.Problems{
 				{Message: `Create RPCs must only require fields explicitly described in AEPs, not "foo"`},
 			},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/field_info.proto";
 				import "aep/api/resource.proto";
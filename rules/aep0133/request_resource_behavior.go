@@ -15,8 +15,8 @@
 package aep0133
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -25,7 +25,7 @@ var requestResourceBehavior = &lint.FieldRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
 		message := f.GetOwner()
-		if !utils.IsCreateRequestMessage(message) {
+		if !descutil.IsCreateRequestMessage(message) {
 			return false
 		}
 		resourceMsgName := getResourceMsgNameFromReq(message)
@@ -34,5 +34,5 @@ var requestResourceBehavior = &lint.FieldRule{
 		}
 		return false
 	},
-	LintField: utils.LintRequiredField,
+	LintField: descutil.LintRequiredField,
 }
@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestResourceBehavior(t *testing.T) {
@@ -26,15 +26,15 @@ func TestRequestResourceBehavior(t *testing.T) {
 		MessageName   string
 		FieldType     string
 		FieldBehavior string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
-		{"Valid", "CreateBookRequest", "Book", " [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED]", testutils.Problems{}},
-		{"Missing", "CreateBookRequest", "Book", "", testutils.Problems{{Message: "(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED"}}},
-		{"IrrelevantOtherField", "CreateBookRequest", "Parchment", "", testutils.Problems{}},
-		{"IrrelevantNotCreateRequest", "FrobBookRequest", "Book", "", testutils.Problems{}},
+		{"Valid", "CreateBookRequest", "Book", " [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED]", ruletest.Problems{}},
+		{"Missing", "CreateBookRequest", "Book", "", ruletest.Problems{{Message: "(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED"}}},
+		{"IrrelevantOtherField", "CreateBookRequest", "Parchment", "", ruletest.Problems{}},
+		{"IrrelevantNotCreateRequest", "FrobBookRequest", "Book", "", ruletest.Problems{}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/field_info.proto";
 				message {{.MessageName}} {
 					{{.FieldType}} book = 1{{.FieldBehavior}};
@@ -17,9 +17,9 @@ package aep0133
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
@@ -28,7 +28,8 @@ import (
 var resourceField = &lint.MessageRule{
 	Name:     lint.NewRuleName(133, "request-resource-field"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsCreateRequestMessage,
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsCreateRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		resourceMsgName := getResourceMsgNameFromReq(m)
 
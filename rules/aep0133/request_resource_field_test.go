@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 )
@@ -29,21 +29,21 @@ func TestResourceField(t *testing.T) {
 		messageName  string
 		messageField *field
 		descriptor   func(*desc.MessageDescriptor) desc.Descriptor
-		problems     testutils.Problems
+		problems     ruletest.Problems
 	}{
 		{
 			"Valid",
 			"CreateBookRequest",
 			&field{"book", builder.FieldTypeMessage(builder.NewMessage("Book"))},
 			nil,
-			testutils.Problems{},
+			ruletest.Problems{},
 		},
 		{
 			"MissingField",
 			"CreateBookRequest",
 			nil,
 			nil,
-			testutils.Problems{{Message: "Message \"CreateBookRequest\" has no \"Book\" type field"}},
+			ruletest.Problems{{Message: "Message \"CreateBookRequest\" has no \"Book\" type field"}},
 		},
 		{
 			"WrongName",
@@ -52,7 +52,7 @@ func TestResourceField(t *testing.T) {
 			func(m *desc.MessageDescriptor) desc.Descriptor {
 				return m.GetFields()[0]
 			},
-			testutils.Problems{{Suggestion: "book"}},
+			ruletest.Problems{{Suggestion: "book"}},
 		},
 	}
 
@@ -17,8 +17,8 @@ package aep0133
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 )
@@ -27,7 +27,7 @@ import (
 var unknownFields = &lint.MessageRule{
 	Name:     lint.NewRuleName(133, "request-unknown-fields"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsCreateRequestMessage,
+	OnlyIf:   descutil.IsCreateRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) (problems []lint.Problem) {
 		resourceMsgName := getResourceMsgNameFromReq(m)
 
@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 )
@@ -33,21 +33,21 @@ func TestUnknownFields(t *testing.T) {
 		testName      string
 		messageName   string
 		messageFields []field
-		problems      testutils.Problems
+		problems      ruletest.Problems
 		problemDesc   func(m *desc.MessageDescriptor) desc.Descriptor
 	}{
 		{
 			"Parent",
 			"CreateBookRequest",
 			[]field{{"parent", builder.FieldTypeString()}},
-			testutils.Problems{},
+			ruletest.Problems{},
 			nil,
 		},
 		{
 			"ValidateOnly",
 			"CreateBookRequest",
 			[]field{{"parent", builder.FieldTypeString()}, {"validate_only", builder.FieldTypeBool()}},
-			testutils.Problems{},
+			ruletest.Problems{},
 			nil,
 		},
 		{
@@ -57,28 +57,28 @@ func TestUnknownFields(t *testing.T) {
 				{"book", builder.FieldTypeMessage(builder.NewMessage("Book"))},
 				{"id", builder.FieldTypeString()},
 			},
-			testutils.Problems{},
+			ruletest.Problems{},
 			nil,
 		},
 		{
 			"ResourceRelatedField",
 			"CreateBookStoreRequest",
 			[]field{{"id", builder.FieldTypeString()}},
-			testutils.Problems{},
+			ruletest.Problems{},
 			nil,
 		},
 		{
 			"RequestIdField",
 			"CreateBookRequest",
 			[]field{{"request_id", builder.FieldTypeString()}},
-			testutils.Problems{},
+			ruletest.Problems{},
 			nil,
 		},
 		{
 			"Invalid",
 			"CreateBookRequest",
 			[]field{{"name", builder.FieldTypeString()}},
-			testutils.Problems{{Message: "Create RPCs must only contain fields explicitly described in AEPs, not \"name\"."}},
+			ruletest.Problems{{Message: "Create RPCs must only contain fields explicitly described in AEPs, not \"name\"."}},
 			func(m *desc.MessageDescriptor) desc.Descriptor {
 				return m.FindFieldByName("name")
 			},
@@ -87,7 +87,7 @@ func TestUnknownFields(t *testing.T) {
 			"InvalidResourceRelatedField",
 			"CreateBookStoreRequest",
 			[]field{{"book_id", builder.FieldTypeString()}},
-			testutils.Problems{{Message: "Create RPCs must only contain fields explicitly described in AEPs, not \"book_id\"."}},
+			ruletest.Problems{{Message: "Create RPCs must only contain fields explicitly described in AEPs, not \"book_id\"."}},
 			func(m *desc.MessageDescriptor) desc.Descriptor {
 				return m.FindFieldByName("book_id")
 			},
@@ -96,7 +96,7 @@ func TestUnknownFields(t *testing.T) {
 			"Irrelevant",
 			"GetBookRequest",
 			[]field{{"name", builder.FieldTypeString()}},
-			testutils.Problems{},
+			ruletest.Problems{},
 			nil,
 		},
 	}
@@ -15,9 +15,9 @@
 package aep0133
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,18 +27,18 @@ var resourceReferenceType = &lint.MethodRule{
 	Name:     lint.NewRuleName(133, "resource-reference-type"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(m *desc.MethodDescriptor) bool {
-		ot := utils.GetResponseType(m)
+		ot := descutil.GetResponseType(m)
 		// Unresolvable response_type for an Operation results in nil here.
-		resource := utils.GetResource(ot)
+		resource := descutil.GetResource(ot)
 		p := m.GetInputType().FindFieldByName("parent")
-		return utils.IsCreateMethod(m) && p != nil && utils.GetResourceReference(p) != nil && resource != nil
+		return descutil.IsCreateMethod(m) && p != nil && descutil.GetResourceReference(p) != nil && resource != nil
 	},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		// Return type of the RPC.
-		ot := utils.GetResponseType(m)
-		resource := utils.GetResource(ot)
+		ot := descutil.GetResponseType(m)
+		resource := descutil.GetResource(ot)
 		parent := m.GetInputType().FindFieldByName("parent")
-		ref := utils.GetResourceReference(parent)
+		ref := descutil.GetResourceReference(parent)
 
 		// Check resource reference matches the created resource type.
 		// In AEP format, use resource_reference_child_type to reference the created resource.
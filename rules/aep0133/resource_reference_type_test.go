@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceReferenceType(t *testing.T) {
@@ -25,18 +25,18 @@ func TestResourceReferenceType(t *testing.T) {
 	tests := []struct {
 		testName   string
 		Annotation string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"ValidMatch_resource_reference", `resource_reference = "library.googleapis.com/Book"`, nil},
-		{"InvalidMismatch_resource_reference", `resource_reference = "library.googleapis.com/Shelf"`, testutils.Problems{{Message: "`resource_reference_child_type`"}}},
+		{"InvalidMismatch_resource_reference", `resource_reference = "library.googleapis.com/Shelf"`, ruletest.Problems{{Message: "`resource_reference_child_type`"}}},
 		{"ValidMatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Book"`, nil},
-		{"InvalidMismatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Shelf"`, testutils.Problems{{Message: "`resource_reference_child_type`"}}},
+		{"InvalidMismatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Shelf"`, ruletest.Problems{{Message: "`resource_reference_child_type`"}}},
 	}
 
 	// Run each test.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 				import "google/longrunning/operations.proto";
@@ -69,19 +69,19 @@ func TestResourceReferenceTypeLRO(t *testing.T) {
 		testName     string
 		Annotation   string
 		ResponseType string
-		problems     testutils.Problems
+		problems     ruletest.Problems
 	}{
 		{"ValidMatch_resource_reference", `resource_reference = "library.googleapis.com/Book"`, "Book", nil},
-		{"InvalidMismatch_resource_reference", `resource_reference = "library.googleapis.com/Shelf"`, "Book", testutils.Problems{{Message: "`resource_reference_child_type`"}}},
+		{"InvalidMismatch_resource_reference", `resource_reference = "library.googleapis.com/Shelf"`, "Book", ruletest.Problems{{Message: "`resource_reference_child_type`"}}},
 		{"ValidMatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Book"`, "Book", nil},
-		{"InvalidMismatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Shelf"`, "Book", testutils.Problems{{Message: "`resource_reference_child_type`"}}},
+		{"InvalidMismatch_resource_reference_child_type", `resource_reference_child_type = "library.googleapis.com/Shelf"`, "Book", ruletest.Problems{{Message: "`resource_reference_child_type`"}}},
 		{"SkipUnresolvableResponse", `resource_reference = "library.googleapis.com/Shelf"`, "Foo", nil},
 	}
 
 	// Run each test.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 				import "google/longrunning/operations.proto";
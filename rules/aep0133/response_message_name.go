@@ -17,25 +17,26 @@ package aep0133
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 // Create method should use the resource as the output message
 var outputName = &lint.MethodRule{
 	Name:     lint.NewRuleName(133, "response-message-name"),
-	OnlyIf:   utils.IsCreateMethod,
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsCreateMethod,
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		want := utils.GetResourceMessageName(m, "Create")
+		want := descutil.GetResourceMessageName(m, "Create")
 
 		// If this is an LRO, then use the annotated response type instead of
 		// the actual RPC return type.
 		got := m.GetOutputType().GetName()
-		if utils.IsOperation(m.GetOutputType()) {
-			got = utils.GetOperationInfo(m).GetResponseType()
+		if descutil.IsOperation(m.GetOutputType()) {
+			got = descutil.GetOperationInfo(m).GetResponseType()
 		}
 
 		// Rule check: Establish that for methods such as `CreateFoo`, the response
@@ -17,7 +17,7 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestOutputMessageName(t *testing.T) {
@@ -27,21 +27,21 @@ func TestOutputMessageName(t *testing.T) {
 		MethodName   string
 		RespTypeName string
 		LRO          bool
-		problems     testutils.Problems
+		problems     ruletest.Problems
 	}{
-		{"ValidResource", "CreateBook", "Book", false, testutils.Problems{}},
-		{"ValidLRO", "CreateBook", "Book", true, testutils.Problems{}},
-		{"ResourceNameContainsOperation", "CreateUnitOperation", "UnitOperation", true, testutils.Problems{}},
-		{"Invalid", "CreateBook", "CreateBookResponse", false, testutils.Problems{{Suggestion: "Book"}}},
-		{"InvalidLRO", "CreateBook", "CreateBookResponse", true, testutils.Problems{{Suggestion: "Book"}}},
-		{"Irrelevant", "BuildBook", "BuildBookResponse", false, testutils.Problems{}},
+		{"ValidResource", "CreateBook", "Book", false, ruletest.Problems{}},
+		{"ValidLRO", "CreateBook", "Book", true, ruletest.Problems{}},
+		{"ResourceNameContainsOperation", "CreateUnitOperation", "UnitOperation", true, ruletest.Problems{}},
+		{"Invalid", "CreateBook", "CreateBookResponse", false, ruletest.Problems{{Suggestion: "Book"}}},
+		{"InvalidLRO", "CreateBook", "CreateBookResponse", true, ruletest.Problems{{Suggestion: "Book"}}},
+		{"Irrelevant", "BuildBook", "BuildBookResponse", false, ruletest.Problems{}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
 			// Create a minimal service with a AEP-134 Update method
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/longrunning/operations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request)
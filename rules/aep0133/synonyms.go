@@ -27,6 +27,7 @@ import (
 var synonyms = &lint.MethodRule{
 	Name:     lint.NewRuleName(133, "synonyms"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		name := m.GetName()
 		for _, syn := range []string{"Insert", "Make", "Post"} {
@@ -17,22 +17,22 @@ package aep0133
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestSynonyms(t *testing.T) {
 	tests := []struct {
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"CreateBook", testutils.Problems{}},
-		{"InsertBook", testutils.Problems{{Suggestion: "CreateBook"}}},
-		{"MakeBook", testutils.Problems{{Suggestion: "CreateBook"}}},
-		{"PostBook", testutils.Problems{{Suggestion: "CreateBook"}}},
+		{"CreateBook", ruletest.Problems{}},
+		{"InsertBook", ruletest.Problems{{Suggestion: "CreateBook"}}},
+		{"MakeBook", ruletest.Problems{{Suggestion: "CreateBook"}}},
+		{"PostBook", ruletest.Problems{{Suggestion: "CreateBook"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.MethodName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book);
 				}
@@ -17,9 +17,9 @@ package aep0134
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
@@ -28,11 +28,11 @@ import (
 var httpBody = &lint.MethodRule{
 	Name:     lint.NewRuleName(134, "http-body"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsUpdateMethod,
+	OnlyIf:   descutil.IsUpdateMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		fieldName := strcase.SnakeCase(m.GetName()[6:])
 		// Establish that the RPC has HTTP body equal to fieldName.
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for _, httpRule := range descutil.GetHTTPRules(m) {
 			if httpRule.Body != fieldName {
 				return []lint.Problem{{
 					Message:    fmt.Sprintf("Update methods should have an HTTP body equal to `%q`.", fieldName),
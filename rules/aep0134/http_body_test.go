@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpBody(t *testing.T) {
@@ -25,18 +25,18 @@ func TestHttpBody(t *testing.T) {
 		testName   string
 		Body       string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", "book", "UpdateBook", nil},
-		{"InvalidFoo", "foo", "UpdateBook", testutils.Problems{{Message: "HTTP body"}}},
-		{"InvalidStar", "*", "UpdateBook", testutils.Problems{{Message: "HTTP body"}}},
-		{"InvalidEmpty", "", "UpdateBook", testutils.Problems{{Message: "HTTP body"}}},
+		{"InvalidFoo", "foo", "UpdateBook", ruletest.Problems{{Message: "HTTP body"}}},
+		{"InvalidStar", "*", "UpdateBook", ruletest.Problems{{Message: "HTTP body"}}},
+		{"InvalidEmpty", "", "UpdateBook", ruletest.Problems{{Message: "HTTP body"}}},
 		{"Irrelevant", "*", "AcquireBook", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
@@ -15,14 +15,14 @@
 package aep0134
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Update methods should use the HTTP PATCH verb.
 var httpMethod = &lint.MethodRule{
 	Name:       lint.NewRuleName(134, "http-method"),
-	OnlyIf:     utils.IsUpdateMethod,
-	LintMethod: utils.LintHTTPMethod("PATCH"),
+	OnlyIf:     descutil.IsUpdateMethod,
+	LintMethod: descutil.LintHTTPMethod("PATCH"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
 }
@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpMethod(t *testing.T) {
@@ -26,17 +26,17 @@ func TestHttpMethod(t *testing.T) {
 		testName   string
 		Method     string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", "patch", "UpdateBook", nil},
-		{"Invalid", "post", "UpdateBook", testutils.Problems{{Message: "HTTP PATCH"}}},
+		{"Invalid", "post", "UpdateBook", ruletest.Problems{{Message: "HTTP PATCH"}}},
 		{"Irrelevant", "post", "AcquireBook", nil},
 	}
 
 	// Run each test.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
@@ -15,8 +15,8 @@
 package aep0134
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -24,8 +24,8 @@ import (
 var httpNameField = &lint.MethodRule{
 	Name:     lint.NewRuleName(134, "http-uri-path"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsUpdateMethod,
+	OnlyIf:   descutil.IsUpdateMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		return utils.LintHTTPURIHasVariable(m, "path")
+		return descutil.LintHTTPURIHasVariable(m, "path")
 	},
 }
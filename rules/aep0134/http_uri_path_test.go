@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpNameField(t *testing.T) {
@@ -25,23 +25,23 @@ func TestHttpNameField(t *testing.T) {
 		testName   string
 		URI        string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{
 			"InvalidWithExtraName", "/v1/{big_book.path=publishers/*/books/*}",
 			"UpdateBigBook",
-			testutils.Problems{{Message: "`path`"}},
+			ruletest.Problems{{Message: "`path`"}},
 		},
-		{"InvalidWithNumber", "/v1/{dv360.path=publishers/*/dv360s/*}", "UpdateDv360", testutils.Problems{{Message: "`path`"}}},
+		{"InvalidWithNumber", "/v1/{dv360.path=publishers/*/dv360s/*}", "UpdateDv360", ruletest.Problems{{Message: "`path`"}}},
 		{
 			"InvalidNoUnderscore", "/v1/{bigbook.path=publishers/*/books/*}",
 			"UpdateBigBook",
-			testutils.Problems{{Message: "`path`"}},
+			ruletest.Problems{{Message: "`path`"}},
 		},
 		{
 			"InvalidVarNameBook", "/v1/{big_book=publishers/*/books/*}",
 			"UpdateBigBook",
-			testutils.Problems{{Message: "`path`"}},
+			ruletest.Problems{{Message: "`path`"}},
 		},
 		{
 			"Valid", "/v1/{path=publishers/*/books/*}", "UpdateBigBook", nil,
@@ -49,12 +49,12 @@ func TestHttpNameField(t *testing.T) {
 		{
 			"InvalidVarNameReversed", "/v1/{path.big_book=publishers/*/books/*}",
 			"UpdateBigBook",
-			testutils.Problems{{Message: "`path`"}},
+			ruletest.Problems{{Message: "`path`"}},
 		},
 		{
 			"NoVarName", "/v1/publishers/*/books/*",
 			"UpdateBigBook",
-			testutils.Problems{{Message: "`path`"}},
+			ruletest.Problems{{Message: "`path`"}},
 		},
 		{
 			"Irrelevant", "/v1/{book=publishers/*/books/*}",
@@ -64,7 +64,7 @@ func TestHttpNameField(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (BigBook) {
@@ -19,19 +19,20 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
 
 var methodSignature = &lint.MethodRule{
 	Name:     lint.NewRuleName(134, "method-signature"),
-	OnlyIf:   utils.IsUpdateMethod,
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsUpdateMethod,
 	RuleType: lint.NewRuleType(lint.ShouldRule),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		signatures := utils.GetMethodSignatures(m)
+		signatures := descutil.GetMethodSignatures(m)
 		want := []string{
 			strcase.SnakeCase(strings.TrimPrefix(m.GetName(), "Update")),
 			"update_mask",
@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestMethodSignature(t *testing.T) {
@@ -25,20 +25,20 @@ func TestMethodSignature(t *testing.T) {
 		name       string
 		MethodName string
 		Signature  string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"Valid", "UpdateBook", `option (google.api.method_signature) = "book,update_mask";`, testutils.Problems{}},
-		{"Missing", "UpdateBook", "", testutils.Problems{{Message: `(google.api.method_signature) = "book,update_mask"`}}},
+		{"Valid", "UpdateBook", `option (google.api.method_signature) = "book,update_mask";`, ruletest.Problems{}},
+		{"Missing", "UpdateBook", "", ruletest.Problems{{Message: `(google.api.method_signature) = "book,update_mask"`}}},
 		{
 			"Wrong",
 			"UpdateBook",
 			`option (google.api.method_signature) = "book";`,
-			testutils.Problems{{Suggestion: `option (google.api.method_signature) = "book,update_mask";`}},
+			ruletest.Problems{{Suggestion: `option (google.api.method_signature) = "book,update_mask";`}},
 		},
-		{"Irrelevant", "EditBook", "", testutils.Problems{}},
+		{"Irrelevant", "EditBook", "", ruletest.Problems{}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/client.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
@@ -15,8 +15,8 @@
 package aep0134
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -24,15 +24,15 @@ var allowMissing = &lint.MessageRule{
 	Name:     lint.NewRuleName(134, "request-allow-missing-field"),
 	RuleType: lint.NewRuleType(lint.MayRule),
 	OnlyIf: func(m *desc.MessageDescriptor) bool {
-		if !utils.IsUpdateRequestMessage(m) {
+		if !descutil.IsUpdateRequestMessage(m) {
 			return false
 		}
-		r := utils.DeclarativeFriendlyResource(m)
-		return r != nil && !utils.IsSingletonResource(r)
+		r := descutil.DeclarativeFriendlyResource(m)
+		return r != nil && !descutil.IsSingletonResource(r)
 	},
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		for _, field := range m.GetFields() {
-			if field.GetName() == "allow_missing" && utils.GetTypeName(field) == "bool" && !field.IsRepeated() {
+			if field.GetName() == "allow_missing" && descutil.GetTypeName(field) == "bool" && !field.IsRepeated() {
 				return nil
 			}
 		}
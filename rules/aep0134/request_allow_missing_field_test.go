@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestAllowMissing(t *testing.T) {
@@ -30,7 +30,7 @@ func TestAllowMissing(t *testing.T) {
 		name         string
 		Pattern      string
 		AllowMissing string
-		problems     testutils.Problems
+		problems     ruletest.Problems
 	}{
 		{"IgnoredNotDF", nonSingletonPattern, "", nil},
 		{"ValidIncluded", nonSingletonPattern, "bool allow_missing = 2;", nil},
@@ -40,7 +40,7 @@ func TestAllowMissing(t *testing.T) {
 		{"IgnoredSingleton", singletonPattern, "", nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 
 				service Library {
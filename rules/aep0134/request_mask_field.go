@@ -15,16 +15,17 @@
 package aep0134
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestMaskField = &lint.FieldRule{
 	Name:     lint.NewRuleName(134, "request-mask-field"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsUpdateRequestMessage(f.GetOwner()) && f.GetName() == "update_mask"
+		return descutil.IsUpdateRequestMessage(f.GetOwner()) && f.GetName() == "update_mask"
 	},
-	LintField: utils.LintFieldMask,
+	LintField: descutil.LintFieldMask,
 }
@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestMaskField(t *testing.T) {
@@ -26,17 +26,17 @@ func TestRequestMaskField(t *testing.T) {
 		MessageName string
 		FieldType   string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "UpdateBookRequest", "google.protobuf.FieldMask", "update_mask", nil},
-		{"InvalidType", "UpdateBookRequest", "string", "update_mask", testutils.Problems{{Suggestion: "google.protobuf.FieldMask"}}},
-		{"InvalidRepeated", "UpdateBookRequest", "repeated google.protobuf.FieldMask", "update_mask", testutils.Problems{{Suggestion: "google.protobuf.FieldMask"}}},
+		{"InvalidType", "UpdateBookRequest", "string", "update_mask", ruletest.Problems{{Suggestion: "google.protobuf.FieldMask"}}},
+		{"InvalidRepeated", "UpdateBookRequest", "repeated google.protobuf.FieldMask", "update_mask", ruletest.Problems{{Suggestion: "google.protobuf.FieldMask"}}},
 		{"IrrelevantMessage", "ModifyBookRequest", "string", "update_mask", nil},
 		{"IrrelevantField", "UpdateBookRequest", "string", "modify_mask", nil},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/protobuf/field_mask.proto";
 				message {{.MessageName}} {
 					{{.FieldType}} {{.FieldName}} = 1;
@@ -15,15 +15,15 @@
 package aep0134
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestMaskRequired = &lint.MessageRule{
 	Name:     lint.NewRuleName(134, "request-mask-required"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsUpdateRequestMessage,
+	OnlyIf:   descutil.IsUpdateRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		updateMask := m.FindFieldByName("update_mask")
 		if updateMask == nil {
@@ -17,21 +17,21 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestMaskFieldRequired(t *testing.T) {
 	tests := []struct {
 		name     string
 		Field    string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "google.protobuf.FieldMask update_mask = 2;", nil},
-		{"InvalidMissing", "", testutils.Problems{{Message: "`update_mask` field"}}},
+		{"InvalidMissing", "", ruletest.Problems{{Message: "`update_mask` field"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/protobuf/field_mask.proto";
 				message UpdateBookRequest {
 					Book book = 1;
@@ -15,14 +15,15 @@
 package aep0134
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Update methods should have a properly named Request message.
 var requestMessageName = &lint.MethodRule{
 	Name:       lint.NewRuleName(134, "request-message-name"),
-	OnlyIf:     utils.IsUpdateMethod,
-	LintMethod: utils.LintMethodHasMatchingRequestName,
+	Metadata:   lint.RuleMetadata{Fixable: true},
+	OnlyIf:     descutil.IsUpdateMethod,
+	LintMethod: descutil.LintMethodHasMatchingRequestName,
 	RuleType:   lint.NewRuleType(lint.MustRule),
 }
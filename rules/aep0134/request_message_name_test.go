@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestMessageName(t *testing.T) {
@@ -26,17 +26,17 @@ func TestRequestMessageName(t *testing.T) {
 		testName       string
 		MethodName     string
 		ReqMessageName string
-		problems       testutils.Problems
+		problems       ruletest.Problems
 	}{
-		{"Valid", "UpdateBook", "UpdateBookRequest", testutils.Problems{}},
-		{"Invalid", "UpdateBook", "Book", testutils.Problems{{Suggestion: "UpdateBookRequest"}}},
-		{"Irrelevant", "AcquireBook", "Book", testutils.Problems{}},
+		{"Valid", "UpdateBook", "UpdateBookRequest", ruletest.Problems{}},
+		{"Invalid", "UpdateBook", "Book", ruletest.Problems{{Suggestion: "UpdateBookRequest"}}},
+		{"Irrelevant", "AcquireBook", "Book", ruletest.Problems{}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.ReqMessageName}}) returns (Book) {}
 				}
@@ -17,14 +17,14 @@ package aep0134
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestPathRequired = &lint.MessageRule{
 	Name:   lint.NewRuleName(134, "request-path-required"),
-	OnlyIf: utils.IsUpdateRequestMessage,
+	OnlyIf: descutil.IsUpdateRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		if m.FindFieldByName("path") == nil {
 			return []lint.Problem{{
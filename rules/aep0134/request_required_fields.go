@@ -18,8 +18,8 @@ import (
 	"fmt"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,15 +27,15 @@ import (
 var requestRequiredFields = &lint.MethodRule{
 	Name:     lint.NewRuleName(134, "request-required-fields"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsUpdateMethod,
+	OnlyIf:   descutil.IsUpdateMethod,
 	LintMethod: func(m *desc.MethodDescriptor) (problems []lint.Problem) {
-		ot := utils.GetResponseType(m)
+		ot := descutil.GetResponseType(m)
 		it := m.GetInputType()
 
 		allowedRequiredFields := stringset.New("update_mask", "path")
 
 		for _, f := range it.GetFields() {
-			if !utils.GetFieldBehavior(f).Contains("REQUIRED") {
+			if !descutil.GetFieldBehavior(f).Contains("REQUIRED") {
 				continue
 			}
 			// Skip the check with the field that is the resource, which for
@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -26,7 +26,7 @@ func TestRequiredFieldTests(t *testing.T) {
 		name                 string
 		Fields               string
 		problematicFieldName string
-		problems             testutils.Problems
+		problems             ruletest.Problems
 	}{
 		{
 			"ValidNoExtraFields",
@@ -56,7 +56,7 @@ func TestRequiredFieldTests(t *testing.T) {
 			"InvalidRequiredValidateOnly",
 			"bool validate_only = 3 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];",
 			"validate_only",
-			testutils.Problems{
+			ruletest.Problems{
 				{Message: `Update RPCs must only require fields explicitly described in AEPs, not "validate_only"`},
 			},
 		},
@@ -64,7 +64,7 @@ func TestRequiredFieldTests(t *testing.T) {
 			"InvalidRequiredUnknownField",
 			"bool create_iam = 3 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];",
 			"create_iam",
-			testutils.Problems{
+			ruletest.Problems{
 				{Message: `Update RPCs must only require fields explicitly described in AEPs, not "create_iam"`},
 			},
 		},
@@ -72,13 +72,13 @@ func TestRequiredFieldTests(t *testing.T) {
 			"InvalidRequiredUnknownMessageField",
 			"Foo foo = 3 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];",
 			"foo",
-			testutils.Problems{
+			ruletest.Problems{
 				{Message: `Update RPCs must only require fields explicitly described in AEPs, not "foo"`},
 			},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				import "aep/api/field_info.proto";
 				import "aep/api/resource.proto";
@@ -17,9 +17,9 @@ package aep0134
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
@@ -28,9 +28,10 @@ import (
 var requestResourceField = &lint.FieldRule{
 	Name:     lint.NewRuleName(134, "request-resource-field"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
 		message := f.GetOwner()
-		return utils.IsUpdateRequestMessage(message) &&
+		return descutil.IsUpdateRequestMessage(message) &&
 			f.GetMessageType() != nil &&
 			f.GetMessageType().GetName() == extractResource(message.GetName())
 	},
@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceField(t *testing.T) {
@@ -27,11 +27,11 @@ func TestResourceField(t *testing.T) {
 		MessageName       string
 		ResourceName      string
 		ResourceFieldName string
-		problems          testutils.Problems
+		problems          ruletest.Problems
 	}{
 		{"Valid", "UpdateBookRequest", "Book", "book", nil},
 		{"Valid", "UpdateBookRequest", "string", "path", nil},
-		{"InvalidFieldName", "UpdateBookRequest", "Book", "big_book", testutils.Problems{{Suggestion: "book"}}},
+		{"InvalidFieldName", "UpdateBookRequest", "Book", "big_book", ruletest.Problems{{Suggestion: "book"}}},
 		{"IrrelevantMessage", "ModifyBookRequest", "Book", "big_book", nil},
 		{"IrrelevantFieldType", "UpdateBookRequest", "string", "big_book", nil},
 		{"IrrelevantFieldMessage", "UpdateBookRequest", "Foo", "big_book", nil},
@@ -40,7 +40,7 @@ func TestResourceField(t *testing.T) {
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/protobuf/field_mask.proto";
 				message {{.MessageName}} {
 					{{.ResourceName}} {{.ResourceFieldName}} = 1;
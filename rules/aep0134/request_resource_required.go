@@ -3,8 +3,8 @@ package aep0134
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -12,7 +12,7 @@ import (
 var requestResourceRequired = &lint.MessageRule{
 	Name:     lint.NewRuleName(134, "request-resource-required"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsUpdateRequestMessage,
+	OnlyIf:   descutil.IsUpdateRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		resourceMsgName := extractResource(m.GetName())
 		for _, fieldDesc := range m.GetFields() {
@@ -3,7 +3,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestResourceFieldRequired(t *testing.T) {
@@ -13,17 +13,17 @@ func TestRequestResourceFieldRequired(t *testing.T) {
 		MessageName       string
 		ResourceName      string
 		ResourceFieldName string
-		problems          testutils.Problems
+		problems          ruletest.Problems
 	}{
 		{"Valid", "UpdateBookRequest", "Book", "book", nil},
 		{"ValidTwoWords", "UpdateBigBookRequest", "BigBook", "big_book", nil},
-		{"InvalidMismatch", "UpdateBookRequest", "Foo", "foo", testutils.Problems{{Message: "has no \"Book\""}}},
+		{"InvalidMismatch", "UpdateBookRequest", "Foo", "foo", ruletest.Problems{{Message: "has no \"Book\""}}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/protobuf/field_mask.proto";
 				message {{.MessageName}} {
 					{{.ResourceName}} {{.ResourceFieldName}} = 1;
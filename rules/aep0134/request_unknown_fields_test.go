@@ -16,7 +16,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 	fpb "google.golang.org/genproto/protobuf/field_mask"
@@ -35,38 +35,38 @@ func TestUnknownFields(t *testing.T) {
 		messageName string
 		fieldName   string
 		fieldType   *builder.FieldType
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		// Use BigBook instead of Book to test correct casing logic
 		{
 			"UpdateMask", "UpdateBigBookRequest", "update_mask",
 			builder.FieldTypeImportedMessage(fieldMask),
-			testutils.Problems{},
+			ruletest.Problems{},
 		},
 		{
 			"ValidateOnly", "UpdateBigBookRequest", "validate_only",
 			builder.FieldTypeBool(),
-			testutils.Problems{},
+			ruletest.Problems{},
 		},
 		{
 			"PathOnly", "UpdateBigBookRequest", "path",
 			builder.FieldTypeString(),
-			testutils.Problems{},
+			ruletest.Problems{},
 		},
 		{
 			"Invalid", "UpdateBigBookRequest", "application_id",
 			builder.FieldTypeString(),
-			testutils.Problems{{Message: "Unexpected field"}},
+			ruletest.Problems{{Message: "Unexpected field"}},
 		},
 		{
 			"InvalidCasing", "UpdateBigBookRequest", "bigbook",
 			builder.FieldTypeString(),
-			testutils.Problems{{Message: "Unexpected field"}},
+			ruletest.Problems{{Message: "Unexpected field"}},
 		},
 		{
 			"Irrelevant", "AcquireBigBookRequest", "application_id",
 			builder.FieldTypeString(),
-			testutils.Problems{},
+			ruletest.Problems{},
 		},
 	}
 
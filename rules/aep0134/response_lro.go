@@ -15,20 +15,21 @@
 package aep0134
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var responseLRO = &lint.MethodRule{
 	Name:     lint.NewRuleName(134, "response-lro"),
 	RuleType: lint.NewRuleType(lint.MayRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(m *desc.MethodDescriptor) bool {
-		return utils.IsUpdateMethod(m) && utils.IsDeclarativeFriendlyMethod(m)
+		return descutil.IsUpdateMethod(m) && descutil.IsDeclarativeFriendlyMethod(m)
 	},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		if !utils.IsOperation(m.GetOutputType()) {
+		if !descutil.IsOperation(m.GetOutputType()) {
 			return []lint.Problem{{
 				Message:    "Declarative-friendly update methods should use an LRO.",
 				Descriptor: m,
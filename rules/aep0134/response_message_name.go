@@ -18,9 +18,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -28,7 +28,8 @@ import (
 var responseMessageName = &lint.MethodRule{
 	Name:     lint.NewRuleName(134, "response-message-name"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsUpdateMethod,
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsUpdateMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `UpdateFoo`, the response
 		// message is `Foo` or `google.longrunning.Operation`.
@@ -36,8 +37,8 @@ var responseMessageName = &lint.MethodRule{
 		got := m.GetOutputType().GetName()
 
 		// If the return type is an LRO, use the annotated response type instead.
-		if utils.IsOperation(m.GetOutputType()) {
-			got = utils.GetOperationInfo(m).GetResponseType()
+		if descutil.IsOperation(m.GetOutputType()) {
+			got = descutil.GetOperationInfo(m).GetResponseType()
 		}
 
 		// Return a problem if we did not get the expected return name.
@@ -17,7 +17,7 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResponseMessageName(t *testing.T) {
@@ -27,21 +27,21 @@ func TestResponseMessageName(t *testing.T) {
 		MethodName   string
 		RespTypeName string
 		LRO          bool
-		problems     testutils.Problems
+		problems     ruletest.Problems
 	}{
-		{"ValidResource", "UpdateBook", "Book", false, testutils.Problems{}},
-		{"ValidLRO", "UpdateBook", "Book", true, testutils.Problems{}},
-		{"ValidLROContainingOperation", "UpdateUnitOperation", "UnitOperation", true, testutils.Problems{}},
-		{"Invalid", "UpdateBook", "UpdateBookResponse", false, testutils.Problems{{Suggestion: "Book"}}},
-		{"InvalidLRO", "UpdateBook", "UpdateBookResponse", true, testutils.Problems{{Suggestion: "Book"}}},
-		{"Irrelevant", "MutateBook", "MutateBookResponse", false, testutils.Problems{}},
+		{"ValidResource", "UpdateBook", "Book", false, ruletest.Problems{}},
+		{"ValidLRO", "UpdateBook", "Book", true, ruletest.Problems{}},
+		{"ValidLROContainingOperation", "UpdateUnitOperation", "UnitOperation", true, ruletest.Problems{}},
+		{"Invalid", "UpdateBook", "UpdateBookResponse", false, ruletest.Problems{{Suggestion: "Book"}}},
+		{"InvalidLRO", "UpdateBook", "UpdateBookResponse", true, ruletest.Problems{{Suggestion: "Book"}}},
+		{"Irrelevant", "MutateBook", "MutateBookResponse", false, ruletest.Problems{}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
 			// Create a minimal service with a AEP-134 Update method
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/longrunning/operations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request)
@@ -27,6 +27,7 @@ import (
 var synonyms = &lint.MethodRule{
 	Name:     lint.NewRuleName(134, "synonyms"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(m *desc.MethodDescriptor) bool {
 		return m.GetName() != "SetIamPolicy"
 	},
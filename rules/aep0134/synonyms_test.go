@@ -17,23 +17,23 @@ package aep0134
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestSynonyms(t *testing.T) {
 	tests := []struct {
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"UpdateBook", testutils.Problems{}},
-		{"PatchBook", testutils.Problems{{Suggestion: "UpdateBook"}}},
-		{"PutBook", testutils.Problems{{Suggestion: "UpdateBook"}}},
-		{"SetBook", testutils.Problems{{Suggestion: "UpdateBook"}}},
+		{"UpdateBook", ruletest.Problems{}},
+		{"PatchBook", ruletest.Problems{{Suggestion: "UpdateBook"}}},
+		{"PutBook", ruletest.Problems{{Suggestion: "UpdateBook"}}},
+		{"SetBook", ruletest.Problems{{Suggestion: "UpdateBook"}}},
 		{"SetIamPolicy", nil},
 	}
 	for _, test := range tests {
 		t.Run(test.MethodName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book);
 				}
@@ -15,8 +15,8 @@
 package aep0135
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -26,20 +26,20 @@ var forceField = &lint.MessageRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(m *desc.MessageDescriptor) bool {
 		name := m.FindFieldByName("path")
-		ref := utils.GetResourceReference(name)
+		ref := descutil.GetResourceReference(name)
 		types := ref.GetType()
-		validRef := ref != nil && len(types) > 0 && utils.FindResource(types[0], m.GetFile()) != nil
+		validRef := ref != nil && len(types) > 0 && descutil.FindResource(types[0], m.GetFile()) != nil
 
-		return utils.IsDeleteRequestMessage(m) && validRef
+		return descutil.IsDeleteRequestMessage(m) && validRef
 	},
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		force := m.FindFieldByName("force")
 		name := m.FindFieldByName("path")
-		ref := utils.GetResourceReference(name)
+		ref := descutil.GetResourceReference(name)
 		types := ref.GetType()
-		res := utils.FindResource(types[0], m.GetFile())
+		res := descutil.FindResource(types[0], m.GetFile())
 
-		children := utils.FindResourceChildren(res, m.GetFile())
+		children := descutil.FindResourceChildren(res, m.GetFile())
 		if len(children) > 0 && force == nil {
 			return []lint.Problem{
 				{
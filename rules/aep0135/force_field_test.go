@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestForceField(t *testing.T) {
@@ -25,16 +25,16 @@ func TestForceField(t *testing.T) {
 		name      string
 		Reference string
 		BoolField string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
 		{"ValidWithChildren", "library.googleapis.com/Publisher", "force", nil},
 		{"ValidWithoutChildren", "library.googleapis.com/Book", "other", nil},
-		{"InvalidMissingForce", "library.googleapis.com/Publisher", "other", testutils.Problems{{Message: "bool force"}}},
+		{"InvalidMissingForce", "library.googleapis.com/Publisher", "other", ruletest.Problems{{Message: "bool force"}}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 
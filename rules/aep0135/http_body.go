@@ -15,14 +15,14 @@
 package aep0135
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Delete methods should not have an HTTP body.
 var httpBody = &lint.MethodRule{
 	Name:       lint.NewRuleName(135, "http-body"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
-	OnlyIf:     utils.IsDeleteMethod,
-	LintMethod: utils.LintNoHTTPBody,
+	OnlyIf:     descutil.IsDeleteMethod,
+	LintMethod: descutil.LintNoHTTPBody,
 }
@@ -15,14 +15,14 @@
 package aep0135
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Delete methods should use the HTTP DELETE method.
 var httpMethod = &lint.MethodRule{
 	Name:       lint.NewRuleName(135, "http-method"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
-	OnlyIf:     utils.IsDeleteMethod,
-	LintMethod: utils.LintHTTPMethod("DELETE"),
+	OnlyIf:     descutil.IsDeleteMethod,
+	LintMethod: descutil.LintHTTPMethod("DELETE"),
 }
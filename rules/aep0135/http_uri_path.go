@@ -15,14 +15,14 @@
 package aep0135
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Delete methods should have a proper HTTP pattern.
 var httpPathField = &lint.MethodRule{
 	Name:       lint.NewRuleName(135, "http-uri-path"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
-	OnlyIf:     utils.IsDeleteMethod,
-	LintMethod: utils.LintHTTPURIHasPathVariable,
+	OnlyIf:     descutil.IsDeleteMethod,
+	LintMethod: descutil.LintHTTPURIHasPathVariable,
 }
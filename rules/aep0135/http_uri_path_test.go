@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpNameField(t *testing.T) {
@@ -25,18 +25,18 @@ func TestHttpNameField(t *testing.T) {
 		testName   string
 		URI        string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", "/v1/{path=publishers/*/books/*}", "DeleteBook", nil},
 		{"ValidRevision", "/v1/{path=publishers/*/books/*}:deleteRevision", "DeleteBookRevision", nil},
-		{"InvalidVarPath", "/v1/{book=publishers/*/books/*}", "DeleteBook", testutils.Problems{{Message: "`path`"}}},
-		{"NoVarPath", "/v1/publishers/*/books/*", "DeleteBook", testutils.Problems{{Message: "`path`"}}},
+		{"InvalidVarPath", "/v1/{book=publishers/*/books/*}", "DeleteBook", ruletest.Problems{{Message: "`path`"}}},
+		{"NoVarPath", "/v1/publishers/*/books/*", "DeleteBook", ruletest.Problems{{Message: "`path`"}}},
 		{"Irrelevant", "/v1/{book=publishers/*/books/*}", "AcquireBook", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book) {
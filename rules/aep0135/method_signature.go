@@ -19,18 +19,19 @@ import (
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var methodSignature = &lint.MethodRule{
 	Name:     lint.NewRuleName(135, "method-signature"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
-	OnlyIf:   utils.IsDeleteMethod,
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   descutil.IsDeleteMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		signatures := utils.GetMethodSignatures(m)
+		signatures := descutil.GetMethodSignatures(m)
 		in := m.GetInputType()
 
 		fields := []string{"path"}
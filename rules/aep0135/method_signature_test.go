@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestMethodSignature(t *testing.T) {
@@ -27,25 +27,25 @@ func TestMethodSignature(t *testing.T) {
 		Signature  string
 		Etag       string
 		Force      string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"Valid", "DeleteBook", `option (google.api.method_signature) = "path";`, "", "", testutils.Problems{}},
-		{"Missing", "DeleteBook", "", "", "", testutils.Problems{{Message: `(google.api.method_signature) = "path"`}}},
+		{"Valid", "DeleteBook", `option (google.api.method_signature) = "path";`, "", "", ruletest.Problems{}},
+		{"Missing", "DeleteBook", "", "", "", ruletest.Problems{{Message: `(google.api.method_signature) = "path"`}}},
 		{
 			"Wrong",
 			"DeleteBook",
 			`option (google.api.method_signature) = "book";`,
 			"", "",
-			testutils.Problems{{Suggestion: `option (google.api.method_signature) = "path";`}},
+			ruletest.Problems{{Suggestion: `option (google.api.method_signature) = "path";`}},
 		},
-		{"Irrelevant", "RemoveBook", "", "", "", testutils.Problems{}},
-		{"WithEtag", "DeleteBook", `option (google.api.method_signature) = "path,etag";`, "string etag = 2;", "", testutils.Problems{}},
-		{"WithForce", "DeleteBook", `option (google.api.method_signature) = "path,force";`, "", "bool force = 3;", testutils.Problems{}},
-		{"WithBoth", "DeleteBook", `option (google.api.method_signature) = "path,etag,force";`, "string etag = 2;", "bool force = 3;", testutils.Problems{}},
-		{"MissingNameWithBoth", "DeleteBook", `option (google.api.method_signature) = "etag,force";`, "string etag = 2;", "bool force = 3;", testutils.Problems{{Suggestion: `option (google.api.method_signature) = "path,etag,force";`}}},
+		{"Irrelevant", "RemoveBook", "", "", "", ruletest.Problems{}},
+		{"WithEtag", "DeleteBook", `option (google.api.method_signature) = "path,etag";`, "string etag = 2;", "", ruletest.Problems{}},
+		{"WithForce", "DeleteBook", `option (google.api.method_signature) = "path,force";`, "", "bool force = 3;", ruletest.Problems{}},
+		{"WithBoth", "DeleteBook", `option (google.api.method_signature) = "path,etag,force";`, "string etag = 2;", "bool force = 3;", ruletest.Problems{}},
+		{"MissingNameWithBoth", "DeleteBook", `option (google.api.method_signature) = "etag,force";`, "string etag = 2;", "bool force = 3;", ruletest.Problems{{Suggestion: `option (google.api.method_signature) = "path,etag,force";`}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/client.proto";
 				import "google/protobuf/empty.proto";
 				service Library {
@@ -15,16 +15,17 @@
 package aep0135
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestForceField = &lint.FieldRule{
 	Name:     lint.NewRuleName(135, "request-force-field"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsDeleteRequestMessage(f.GetOwner()) && f.GetName() == "force"
+		return descutil.IsDeleteRequestMessage(f.GetOwner()) && f.GetName() == "force"
 	},
-	LintField: utils.LintSingularBoolField,
+	LintField: descutil.LintSingularBoolField,
 }
@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestForceField(t *testing.T) {
@@ -26,18 +26,18 @@ func TestRequestForceField(t *testing.T) {
 		MessageName string
 		FieldType   string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "DeleteBookRequest", "bool", "force", nil},
-		{"Invalid", "DeleteBookRequest", "int32", "force", testutils.Problems{{Suggestion: "bool"}}},
-		{"InvalidRepeated", "DeleteBookRequest", "repeated bool", "force", testutils.Problems{{Suggestion: "bool"}}},
+		{"Invalid", "DeleteBookRequest", "int32", "force", ruletest.Problems{{Suggestion: "bool"}}},
+		{"InvalidRepeated", "DeleteBookRequest", "repeated bool", "force", ruletest.Problems{{Suggestion: "bool"}}},
 		{"IrrelevantMessage", "RemoveBookRequest", "int32", "force", nil},
 		{"IrrelevantField", "DeleteBookRequest", "string", "name", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					{{.FieldType}} {{.FieldName}} = 1;
 				}
@@ -15,8 +15,8 @@
 package aep0135
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -24,7 +24,7 @@ var requestPathBehavior = &lint.FieldRule{
 	Name:     lint.NewRuleName(135, "request-path-behavior"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsDeleteRequestMessage(f.GetOwner()) && f.GetName() == "path"
+		return descutil.IsDeleteRequestMessage(f.GetOwner()) && f.GetName() == "path"
 	},
-	LintField: utils.LintRequiredField,
+	LintField: descutil.LintRequiredField,
 }
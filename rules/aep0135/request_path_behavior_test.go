@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestNameBehavior(t *testing.T) {
@@ -25,14 +25,14 @@ func TestRequestNameBehavior(t *testing.T) {
 		name          string
 		FieldName     string
 		FieldBehavior string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
-		{"Valid", "path", " [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED]", testutils.Problems{}},
-		{"Missing", "path", "", testutils.Problems{{Message: "(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED"}}},
-		{"Irrelevant", "something_else", "", testutils.Problems{}},
+		{"Valid", "path", " [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED]", ruletest.Problems{}},
+		{"Missing", "path", "", ruletest.Problems{{Message: "(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED"}}},
+		{"Irrelevant", "something_else", "", ruletest.Problems{}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/field_info.proto";
 				message DeleteBookRequest {
 					string {{.FieldName}} = 1{{.FieldBehavior}};
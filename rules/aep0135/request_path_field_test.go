@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestNameField(t *testing.T) {
@@ -26,17 +26,17 @@ func TestRequestNameField(t *testing.T) {
 		MessageName string
 		FieldType   string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "DeleteBookRequest", "string", "path", nil},
-		{"Invalid", "DeleteBookRequest", "bytes", "path", testutils.Problems{{Suggestion: "string"}}},
+		{"Invalid", "DeleteBookRequest", "bytes", "path", ruletest.Problems{{Suggestion: "string"}}},
 		{"IrrelevantMessage", "RemoveBookRequest", "bytes", "path", nil},
 		{"IrrelevantField", "DeleteBookRequest", "bytes", "title", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.path, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					{{.FieldType}} {{.FieldName}} = 1;
 				}
@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestNameRequired(t *testing.T) {
@@ -26,17 +26,17 @@ func TestRequestNameRequired(t *testing.T) {
 		name        string
 		MessageName string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "DeleteBookRequest", "path", nil},
-		{"InvalidName", "DeleteBookRequest", "id", testutils.Problems{{Message: "path"}}},
+		{"InvalidName", "DeleteBookRequest", "id", ruletest.Problems{{Message: "path"}}},
 		{"Irrelevant", "RemoveBookRequest", "id", nil},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					string {{.FieldName}} = 1;
 				}
@@ -18,8 +18,8 @@ import (
 	"fmt"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,13 +27,13 @@ import (
 var requestRequiredFields = &lint.MessageRule{
 	Name:     lint.NewRuleName(135, "request-required-fields"),
 	RuleType: lint.NewRuleType(lint.MustRule),
-	OnlyIf:   utils.IsDeleteRequestMessage,
+	OnlyIf:   descutil.IsDeleteRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) (problems []lint.Problem) {
 		// Rule check: Establish that there are no unexpected fields.
 		allowedRequiredFields := stringset.New("path")
 
 		for _, f := range m.GetFields() {
-			if !utils.GetFieldBehavior(f).Contains("REQUIRED") {
+			if !descutil.GetFieldBehavior(f).Contains("REQUIRED") {
 				continue
 			}
 			// Iterate remaining fields. If they're not in the allowed list,
@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc/builder"
 )
 
@@ -28,17 +28,17 @@ func TestUnknownFields(t *testing.T) {
 		messageName string
 		fieldName   string
 		fieldType   *builder.FieldType
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
-		{"Force", "DeleteBookRequest", "force", builder.FieldTypeBool(), testutils.Problems{}},
-		{"Etag", "DeleteBookRequest", "etag", builder.FieldTypeString(), testutils.Problems{}},
-		{"AllowMissing", "DeleteBookRequest", "allow_missing", builder.FieldTypeBool(), testutils.Problems{}},
-		{"RequestId", "DeleteBookRequest", "request_id", builder.FieldTypeString(), testutils.Problems{}},
-		{"ValidateOnly", "DeleteBookRequest", "validate_only", builder.FieldTypeBool(), testutils.Problems{}},
-		{"Invalid", "DeleteBookRequest", "application_id", builder.FieldTypeString(), testutils.Problems{{
+		{"Force", "DeleteBookRequest", "force", builder.FieldTypeBool(), ruletest.Problems{}},
+		{"Etag", "DeleteBookRequest", "etag", builder.FieldTypeString(), ruletest.Problems{}},
+		{"AllowMissing", "DeleteBookRequest", "allow_missing", builder.FieldTypeBool(), ruletest.Problems{}},
+		{"RequestId", "DeleteBookRequest", "request_id", builder.FieldTypeString(), ruletest.Problems{}},
+		{"ValidateOnly", "DeleteBookRequest", "validate_only", builder.FieldTypeBool(), ruletest.Problems{}},
+		{"Invalid", "DeleteBookRequest", "application_id", builder.FieldTypeString(), ruletest.Problems{{
 			Message: "Unexpected field",
 		}}},
-		{"Irrelevant", "RemoveBookRequest", "application_id", builder.FieldTypeString(), testutils.Problems{}},
+		{"Irrelevant", "RemoveBookRequest", "application_id", builder.FieldTypeString(), ruletest.Problems{}},
 	}
 
 	// Run each test individually.
@@ -15,20 +15,21 @@
 package aep0135
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var responseLRO = &lint.MethodRule{
 	Name:     lint.NewRuleName(135, "response-lro"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(m *desc.MethodDescriptor) bool {
-		return utils.IsDeleteMethod(m) && utils.IsDeclarativeFriendlyMethod(m)
+		return descutil.IsDeleteMethod(m) && descutil.IsDeclarativeFriendlyMethod(m)
 	},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		if !utils.IsOperation(m.GetOutputType()) {
+		if !descutil.IsOperation(m.GetOutputType()) {
 			return []lint.Problem{{
 				Message:    "Declarative-friendly delete methods should use an LRO.",
 				Descriptor: m,
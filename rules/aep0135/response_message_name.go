@@ -19,9 +19,9 @@ import (
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -30,7 +30,7 @@ import (
 // message.
 var responseMessageName = &lint.MethodRule{
 	Name:   lint.NewRuleName(135, "response-message-name"),
-	OnlyIf: utils.IsDeleteMethod,
+	OnlyIf: descutil.IsDeleteMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		resource := strings.Replace(m.GetName(), "Delete", "", 1)
 
@@ -44,8 +44,8 @@ var responseMessageName = &lint.MethodRule{
 
 		// If the return type is an Operation, use the annotated response type.
 		lro := false
-		if utils.IsOperation(m.GetOutputType()) {
-			got = utils.GetOperationInfo(m).GetResponseType()
+		if descutil.IsOperation(m.GetOutputType()) {
+			got = descutil.GetOperationInfo(m).GetResponseType()
 			lro = true
 		}
 
@@ -17,7 +17,7 @@ package aep0135
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResponseMessageName(t *testing.T) {
@@ -64,7 +64,7 @@ func TestResponseMessageName(t *testing.T) {
 	}
 
 	// Prepare problems.
-	problems := map[string]map[string]testutils.Problems{
+	problems := map[string]map[string]ruletest.Problems{
 		"book": {
 			"sync": {{Suggestion: "Book", Message: "of the resource"}},
 			"lro":  {{Message: "of the resource"}},
@@ -82,7 +82,7 @@ func TestResponseMessageName(t *testing.T) {
 		MethodName   string
 		RespTypeName string
 		Style        string
-		problems     map[string]testutils.Problems
+		problems     map[string]ruletest.Problems
 	}{
 		{"ValidEmpty", "DeleteBook", "google.protobuf.Empty", "", problems["none"]},
 		// the declarative friendly style is no longer deviated for delete.
@@ -99,7 +99,7 @@ func TestResponseMessageName(t *testing.T) {
 			for _, tmplName := range []string{"sync", "lro"} {
 				t.Run(tmplName, func(t *testing.T) {
 					// Create a minimal service with a AEP-135 Delete method
-					file := testutils.ParseProto3Tmpl(t, tmpl[tmplName], test)
+					file := ruletest.ParseProto3Tmpl(t, tmpl[tmplName], test)
 
 					// Run the lint rule, and establish that it returns the expected problems.
 					method := file.GetServices()[0].GetMethods()[0]
@@ -18,8 +18,8 @@ package aep0136
 import (
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -43,7 +43,7 @@ func AddRules(r lint.RuleRegistry) error {
 func isCustomMethod(m *desc.MethodDescriptor) bool {
 	// Anything with a `:` in the method URI is automatically a custom
 	// method, regardless of the RPC name.
-	for _, httpRule := range utils.GetHTTPRules(m) {
+	for _, httpRule := range descutil.GetHTTPRules(m) {
 		if strings.Contains(httpRule.GetPlainURI(), ":") {
 			return true
 		}
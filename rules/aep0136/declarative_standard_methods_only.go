@@ -18,15 +18,15 @@ import (
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var standardMethodsOnly = &lint.MethodRule{
 	Name:     lint.NewRuleName(136, "declarative-standard-methods-only"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
-	OnlyIf:   utils.IsDeclarativeFriendlyMethod,
+	OnlyIf:   descutil.IsDeclarativeFriendlyMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		// Standard methods are fine.
 		standard := stringset.New("Get", "List", "Create", "Update", "Delete", "Undelete", "Batch")
@@ -17,7 +17,7 @@ package aep0136
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestDeclarativeFriendly(t *testing.T) {
@@ -28,7 +28,7 @@ func TestDeclarativeFriendly(t *testing.T) {
 		name       string
 		MethodName string
 		ImpOnly    string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"ValidGet", "GetBook", "", nil},
 		{"ValidList", "ListBooks", "", nil},
@@ -41,7 +41,7 @@ func TestDeclarativeFriendly(t *testing.T) {
 		{"NoLongerInvalid", "FrobBook", "", nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 
 				service Library {
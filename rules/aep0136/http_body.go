@@ -18,9 +18,9 @@ import (
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/stoewer/go-strcase"
 )
@@ -30,7 +30,7 @@ var httpBody = &lint.MethodRule{
 	RuleType: lint.NewRuleType(lint.ShouldRule),
 	OnlyIf:   isCustomMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for _, httpRule := range descutil.GetHTTPRules(m) {
 			noBody := stringset.New("GET", "DELETE")
 			if !noBody.Contains(httpRule.Method) {
 				// Determine the path of the resource.
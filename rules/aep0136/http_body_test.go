@@ -17,7 +17,7 @@ package aep0136
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpBody(t *testing.T) {
@@ -26,20 +26,20 @@ func TestHttpBody(t *testing.T) {
 		MethodName string
 		Method     string
 		Body       string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"ValidPostBody", "ArchiveBook", "post", "*", testutils.Problems{}},
-		{"ValidPostBodyBook", "ArchiveBook", "post", "book", testutils.Problems{}},
-		{"InvalidPostBody", "ArchiveBook", "post", "random", testutils.Problems{{Message: `body: "*"`}}},
-		{"InvalidPostNoBody", "ArchiveBook", "post", "", testutils.Problems{{Message: `body: "*"`}}},
-		{"ValidGetNoBody", "ReadBook", "get", "", testutils.Problems{}},
-		{"InvalidGetBody", "ReadBook", "get", "*", testutils.Problems{{Message: "should not set"}}},
-		{"InvalidGetOtherBody", "ReadBook", "get", "Book", testutils.Problems{{Message: "should not set"}}},
-		{"ValidStdMethod", "CreateBook", "post", "book", testutils.Problems{}},
+		{"ValidPostBody", "ArchiveBook", "post", "*", ruletest.Problems{}},
+		{"ValidPostBodyBook", "ArchiveBook", "post", "book", ruletest.Problems{}},
+		{"InvalidPostBody", "ArchiveBook", "post", "random", ruletest.Problems{{Message: `body: "*"`}}},
+		{"InvalidPostNoBody", "ArchiveBook", "post", "", ruletest.Problems{{Message: `body: "*"`}}},
+		{"ValidGetNoBody", "ReadBook", "get", "", ruletest.Problems{}},
+		{"InvalidGetBody", "ReadBook", "get", "*", ruletest.Problems{{Message: "should not set"}}},
+		{"InvalidGetOtherBody", "ReadBook", "get", "Book", ruletest.Problems{{Message: "should not set"}}},
+		{"ValidStdMethod", "CreateBook", "post", "book", ruletest.Problems{}},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns ({{.MethodName}}Response) {
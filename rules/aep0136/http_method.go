@@ -17,9 +17,9 @@ package aep0136
 import (
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -36,7 +36,7 @@ var httpMethod = &lint.MethodRule{
 		}
 
 		// Run the normal check for POST or GET.
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for _, httpRule := range descutil.GetHTTPRules(m) {
 			if httpRule.Method != "POST" && httpRule.Method != "GET" {
 				return []lint.Problem{{
 					Message:    "Custom methods should use the HTTP POST or GET method.",
@@ -17,7 +17,7 @@ package aep0136
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpMethod(t *testing.T) {
@@ -26,21 +26,21 @@ func TestHttpMethod(t *testing.T) {
 		MethodName string
 		HTTPMethod string
 		Suffix     string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"ValidGet", "ArchiveBook", "get", "", nil},
 		{"ValidPost", "ArchiveBook", "post", "", nil},
 		{"ValidDeleteRevision", "DeleteBookRevision", "delete", ":deleteRevision", nil},
-		{"InvalidPut", "ArchiveBook", "put", ":archive", testutils.Problems{{Message: "POST or GET"}}},
-		{"InvalidPatch", "ArchiveBook", "patch", ":archive", testutils.Problems{{Message: "POST or GET"}}},
-		{"InvalidDelete", "ArchiveBook", "delete", "", testutils.Problems{{Message: "POST or GET"}}},
+		{"InvalidPut", "ArchiveBook", "put", ":archive", ruletest.Problems{{Message: "POST or GET"}}},
+		{"InvalidPatch", "ArchiveBook", "patch", ":archive", ruletest.Problems{{Message: "POST or GET"}}},
+		{"InvalidDelete", "ArchiveBook", "delete", "", ruletest.Problems{{Message: "POST or GET"}}},
 		{"IrrelevantPatch", "UpdateBook", "patch", "", nil},
 		{"IrrelevantDelete", "DeleteBook", "delete", "", nil},
 		{"IrrelevantPut", "ReplaceBook", "put", "", nil},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns ({{.MethodName}}Response) {
@@ -17,21 +17,21 @@ package aep0136
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestNoPrepositions(t *testing.T) {
 	tests := []struct {
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"ArchiveBook", testutils.Problems{}},
-		{"MoveToBook", testutils.Problems{{Message: "to"}}},
-		{"MoveFromShelfToShelf", testutils.Problems{{Message: "from"}, {Message: "to"}}},
+		{"ArchiveBook", ruletest.Problems{}},
+		{"MoveToBook", ruletest.Problems{{Message: "to"}}},
+		{"MoveFromShelfToShelf", ruletest.Problems{{Message: "from"}, {Message: "to"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.MethodName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns ({{.MethodName}}Response);
 				}
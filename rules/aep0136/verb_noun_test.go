@@ -17,20 +17,20 @@ package aep0136
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestVerbNoun(t *testing.T) {
 	tests := []struct {
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"ArchiveBook", testutils.Problems{}},
-		{"Archive", testutils.Problems{{Message: "verb followed by a noun"}}},
+		{"ArchiveBook", ruletest.Problems{}},
+		{"Archive", ruletest.Problems{{Message: "verb followed by a noun"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.MethodName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns ({{.MethodName}}Response);
 				}
@@ -17,8 +17,8 @@ package aep0141
 import (
 	"testing"
 
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
 )
 
 func TestCount(t *testing.T) {
@@ -36,13 +36,13 @@ func TestCount(t *testing.T) {
 		{"NumGeese", "num_geese", "goose_count", true}, // singular of "geese" is "goose"
 	}
 	for _, test := range tests {
-		file := testutils.ParseProto3Tmpl(t, `
+		file := ruletest.ParseProto3Tmpl(t, `
 			message Job {
 				int32 {{.FieldName}} = 1;
 			}
 		`, test)
 		field := file.GetMessageTypes()[0].GetFields()[0]
-		wantProblems := testutils.Problems{}
+		wantProblems := ruletest.Problems{}
 		if test.err {
 			wantProblems = append(wantProblems, lint.Problem{
 				Message:    "_count suffix",
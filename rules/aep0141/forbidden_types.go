@@ -18,18 +18,19 @@ import (
 	"fmt"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var forbiddenTypes = &lint.FieldRule{
 	Name:     lint.NewRuleName(141, "forbidden-types"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
 		nope := stringset.New("fixed32", "fixed64", "uint32", "uint64")
-		if typeName := utils.GetTypeName(f); nope.Contains(typeName) {
+		if typeName := descutil.GetTypeName(f); nope.Contains(typeName) {
 			// Preserve original intent w/r/t 32-bit vs. 64-bit.
 			want := "int" + typeName[len(typeName)-2:]
 			return []lint.Problem{{
@@ -17,24 +17,24 @@ package aep0141
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestForbiddenTypes(t *testing.T) {
 	tests := []struct {
 		TypeName string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"int32", testutils.Problems{}},
-		{"int64", testutils.Problems{}},
-		{"uint32", testutils.Problems{{Suggestion: "int32"}}},
-		{"uint64", testutils.Problems{{Suggestion: "int64"}}},
-		{"fixed32", testutils.Problems{{Suggestion: "int32"}}},
-		{"fixed64", testutils.Problems{{Suggestion: "int64"}}},
+		{"int32", ruletest.Problems{}},
+		{"int64", ruletest.Problems{}},
+		{"uint32", ruletest.Problems{{Suggestion: "int32"}}},
+		{"uint64", ruletest.Problems{{Suggestion: "int64"}}},
+		{"fixed32", ruletest.Problems{{Suggestion: "int32"}}},
+		{"fixed64", ruletest.Problems{{Suggestion: "int64"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.TypeName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				message Book {
 					{{.TypeName}} pages = 1;
 				}
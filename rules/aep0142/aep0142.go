@@ -16,8 +16,8 @@
 package aep0142
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -33,5 +33,5 @@ func AddRules(r lint.RuleRegistry) error {
 // isTimestamp simply returns if the field is of type google.protobuf.Timestamp
 // or not.
 func isTimestamp(f *desc.FieldDescriptor) bool {
-	return utils.GetTypeName(f) == "google.protobuf.Timestamp"
+	return descutil.GetTypeName(f) == "google.protobuf.Timestamp"
 }
@@ -24,6 +24,7 @@ import (
 
 var fieldNames = &lint.FieldRule{
 	Name:     lint.NewRuleName(142, "time-field-names"),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf:   isTimestamp,
 	RuleType: lint.NewRuleType(lint.ShouldRule),
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
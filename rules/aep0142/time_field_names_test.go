@@ -17,7 +17,7 @@ package aep0142
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestFieldName(t *testing.T) {
@@ -25,21 +25,21 @@ func TestFieldName(t *testing.T) {
 		testName  string
 		FieldType string
 		FieldName string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
-		{"Valid", "google.protobuf.Timestamp", "create_time", testutils.Problems{}},
-		{"InvalidIsMistake", "google.protobuf.Timestamp", "created", testutils.Problems{{Suggestion: "create_time"}}},
-		{"InvalidContainsMistake", "google.protobuf.Timestamp", "last_modified", testutils.Problems{{Suggestion: "update_time"}}},
-		{"InvalidNoSuffix", "google.protobuf.Timestamp", "create", testutils.Problems{{Message: "should end"}}},
-		{"ValidSuffixRepeatedPlural", "repeated google.protobuf.Timestamp", "sample_times", testutils.Problems{}},
-		{"ValidSuffixRepeated", "repeated google.protobuf.Timestamp", "sample_time", testutils.Problems{}},
-		{"InvalidNoSuffixRepeated", "repeated google.protobuf.Timestamp", "create", testutils.Problems{{Message: "should end"}}},
-		{"SkipNonTimestamp", "int32", "created", testutils.Problems{}},
+		{"Valid", "google.protobuf.Timestamp", "create_time", ruletest.Problems{}},
+		{"InvalidIsMistake", "google.protobuf.Timestamp", "created", ruletest.Problems{{Suggestion: "create_time"}}},
+		{"InvalidContainsMistake", "google.protobuf.Timestamp", "last_modified", ruletest.Problems{{Suggestion: "update_time"}}},
+		{"InvalidNoSuffix", "google.protobuf.Timestamp", "create", ruletest.Problems{{Message: "should end"}}},
+		{"ValidSuffixRepeatedPlural", "repeated google.protobuf.Timestamp", "sample_times", ruletest.Problems{}},
+		{"ValidSuffixRepeated", "repeated google.protobuf.Timestamp", "sample_time", ruletest.Problems{}},
+		{"InvalidNoSuffixRepeated", "repeated google.protobuf.Timestamp", "create", ruletest.Problems{{Message: "should end"}}},
+		{"SkipNonTimestamp", "int32", "created", ruletest.Problems{}},
 		{"IrrelevantWeirdType", "bytes", "created", nil},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/protobuf/timestamp.proto";
 				message Book {
 					{{.FieldType}} {{.FieldName}} = 1;
@@ -18,19 +18,20 @@ import (
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var fieldType = &lint.FieldRule{
 	Name:     lint.NewRuleName(142, "time-field-type"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
 		tokens := strings.Split(f.GetName(), "_")
 		suffix := tokens[len(tokens)-1]
-		typeName := utils.GetTypeName(f)
+		typeName := descutil.GetTypeName(f)
 		suffixes := stringset.New(
 			"date", "datetime", "ms", "msec", "msecs", "millis", "nanos", "ns",
 			"nsec", "nsecs", "sec", "secs", "seconds", "time", "timestamp", "us",
@@ -17,7 +17,7 @@ package aep0142
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestFieldType(t *testing.T) {
@@ -25,18 +25,18 @@ func TestFieldType(t *testing.T) {
 		testName  string
 		FieldType string
 		FieldName string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
-		{"Valid", "google.protobuf.Timestamp", "create_time", testutils.Problems{}},
-		{"Valid Date", "google.type.Date", "birth_date", testutils.Problems{}},
-		{"Valid Civil Time", "google.type.TimeOfDay", "civil_time", testutils.Problems{}},
-		{"Valid DateTime", "google.type.DateTime", "local_time", testutils.Problems{}},
-		{"Invalid", "int32", "created_ms", testutils.Problems{{Suggestion: "google.protobuf.Timestamp"}}},
-		{"Irrelevant", "int32", "foo", testutils.Problems{}},
+		{"Valid", "google.protobuf.Timestamp", "create_time", ruletest.Problems{}},
+		{"Valid Date", "google.type.Date", "birth_date", ruletest.Problems{}},
+		{"Valid Civil Time", "google.type.TimeOfDay", "civil_time", ruletest.Problems{}},
+		{"Valid DateTime", "google.type.DateTime", "local_time", ruletest.Problems{}},
+		{"Invalid", "int32", "created_ms", ruletest.Problems{{Suggestion: "google.protobuf.Timestamp"}}},
+		{"Irrelevant", "int32", "foo", ruletest.Problems{}},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/type/date.proto";
 				import "google/type/datetime.proto";
 				import "google/type/timeofday.proto";
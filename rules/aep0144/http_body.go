@@ -15,14 +15,14 @@
 package aep0144
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Add/Remove methods should use "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
 	Name:       lint.NewRuleName(144, "http-body"),
 	OnlyIf:     isAddRemoveMethod,
-	LintMethod: utils.LintWildcardHTTPBody,
+	LintMethod: descutil.LintWildcardHTTPBody,
 	RuleType:   lint.NewRuleType(lint.ShouldRule),
 }
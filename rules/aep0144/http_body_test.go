@@ -17,7 +17,7 @@ package aep0144
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHTTPBody(t *testing.T) {
@@ -25,18 +25,18 @@ func TestHTTPBody(t *testing.T) {
 		testName   string
 		Body       string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"ValidAdd", "*", "AddAuthor", nil},
-		{"InvalidAdd", "", "AddAuthor", testutils.Problems{{Message: "HTTP body"}}},
+		{"InvalidAdd", "", "AddAuthor", ruletest.Problems{{Message: "HTTP body"}}},
 		{"ValidRemove", "*", "RemoveAuthor", nil},
-		{"InvalidRemove", "", "RemoveAuthor", testutils.Problems{{Message: "HTTP body"}}},
+		{"InvalidRemove", "", "RemoveAuthor", ruletest.Problems{{Message: "HTTP body"}}},
 		{"Irrelevant", "*", "AcquireBook", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns ({{.MethodName}}Response) {
@@ -15,14 +15,14 @@
 package aep0144
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Add/Remove methods must use the HTTP POST method.
 var httpMethod = &lint.MethodRule{
 	Name:       lint.NewRuleName(144, "http-method"),
 	OnlyIf:     isAddRemoveMethod,
-	LintMethod: utils.LintHTTPMethod("POST"),
+	LintMethod: descutil.LintHTTPMethod("POST"),
 	RuleType:   lint.NewRuleType(lint.MustRule),
 }
@@ -17,7 +17,7 @@ package aep0144
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHTTPMethod(t *testing.T) {
@@ -26,19 +26,19 @@ func TestHTTPMethod(t *testing.T) {
 		testName   string
 		Method     string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"ValidAdd", "post", "AddAuthor", nil},
-		{"InvalidAdd", "patch", "AddAuthor", testutils.Problems{{Message: "HTTP POST"}}},
+		{"InvalidAdd", "patch", "AddAuthor", ruletest.Problems{{Message: "HTTP POST"}}},
 		{"ValidRemove", "post", "RemoveAuthor", nil},
-		{"InvalidRemove", "patch", "RemoveAuthor", testutils.Problems{{Message: "HTTP POST"}}},
+		{"InvalidRemove", "patch", "RemoveAuthor", ruletest.Problems{{Message: "HTTP POST"}}},
 		{"Irrelevant", "get", "AcquireBook", nil},
 	}
 
 	// Run each test.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns ({{.MethodName}}Response) {
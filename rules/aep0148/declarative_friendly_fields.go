@@ -19,8 +19,8 @@ import (
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -28,13 +28,13 @@ var declarativeFriendlyRequired = &lint.MessageRule{
 	Name:     lint.NewRuleName(148, "declarative-friendly-fields"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(m *desc.MessageDescriptor) bool {
-		if resource := utils.DeclarativeFriendlyResource(m); resource == m {
+		if resource := descutil.DeclarativeFriendlyResource(m); resource == m {
 			return true
 		}
 		return false
 	},
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		singleton := utils.IsSingletonResource(m)
+		singleton := descutil.IsSingletonResource(m)
 		// Define the fields that are expected.
 		missingFields := stringset.New()
 		for name, typ := range reqFields {
@@ -42,7 +42,7 @@ var declarativeFriendlyRequired = &lint.MessageRule{
 				continue
 			}
 			f := m.FindFieldByName(name)
-			if f == nil || utils.GetTypeName(f) != typ {
+			if f == nil || descutil.GetTypeName(f) != typ {
 				missingFields.Add(fmt.Sprintf("%s %s", typ, name))
 			}
 		}
@@ -19,7 +19,7 @@ import (
 	"testing"
 
 	"bitbucket.org/creachadair/stringset"
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestDeclarativeFriendlyFields(t *testing.T) {
@@ -51,7 +51,7 @@ func TestDeclarativeFriendlyFields(t *testing.T) {
 			// Note: AEP ResourceDescriptor doesn't have a style field, so declarative-friendly
 			// detection is not possible. All tests expect nil since resources won't be
 			// detected as declarative-friendly.
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/protobuf/timestamp.proto";
 				message Book {
@@ -66,7 +66,7 @@ func TestDeclarativeFriendlyFields(t *testing.T) {
 			}{Fields: fields})
 			m := f.GetMessageTypes()[0]
 			got := declarativeFriendlyRequired.Lint(f)
-			if diff := testutils.Problems(nil).SetDescriptor(m).Diff(got); diff != "" {
+			if diff := ruletest.Problems(nil).SetDescriptor(m).Diff(got); diff != "" {
 				t.Error(diff)
 			}
 		})
@@ -80,7 +80,7 @@ func TestDeclarativeFriendlyFieldsSingleton(t *testing.T) {
 	for _, test := range []struct {
 		name   string
 		Fields string
-		want   testutils.Problems
+		want   ruletest.Problems
 	}{
 		{
 			"NoLongerInvalidNoCreateTime", `string path = 1; string display_name = 2; google.protobuf.Timestamp update_time = 3;`,
@@ -93,7 +93,7 @@ func TestDeclarativeFriendlyFieldsSingleton(t *testing.T) {
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/protobuf/timestamp.proto";
 				message Book {
@@ -16,8 +16,8 @@ package aep0148
 
 import (
 	"bitbucket.org/creachadair/stringset"
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -25,9 +25,9 @@ var fieldBehavior = &lint.FieldRule{
 	Name:     lint.NewRuleName(148, "field-behavior"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
-		return utils.IsResource(f.GetOwner()) && outputOnlyFields.Contains(f.GetName())
+		return descutil.IsResource(f.GetOwner()) && outputOnlyFields.Contains(f.GetName())
 	},
-	LintField: utils.LintOutputOnlyField,
+	LintField: descutil.LintOutputOnlyField,
 }
 
 var outputOnlyFields = stringset.New(
@@ -17,20 +17,21 @@ package aep0148
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestFieldBehavior(t *testing.T) {
 	const messageOptsResource = `option (aep.api.resource).type = "library.googleapis.com/Book";`
 	const fieldOptsOutputOnly = `[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]`
-	missingOutputOnly := testutils.Problems{{Message: "OUTPUT_ONLY"}}
+	missingOutputOnly := ruletest.Problems{{Message: "OUTPUT_ONLY"}}
 
 	for _, test := range []struct {
 		name        string
 		MessageOpts string
 		Field       string
 		FieldOpts   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"ValidCreateTime", messageOptsResource, `google.protobuf.Timestamp create_time`, fieldOptsOutputOnly, nil},
 		{"InvalidCreateTime", messageOptsResource, `google.protobuf.Timestamp create_time`, ``, missingOutputOnly},
@@ -38,13 +39,14 @@ func TestFieldBehavior(t *testing.T) {
 		{"InvalidDeleteTime", messageOptsResource, `google.protobuf.Timestamp delete_time`, ``, missingOutputOnly},
 		{"ValidUid", messageOptsResource, `string uid`, fieldOptsOutputOnly, nil},
 		{"InvalidUid", messageOptsResource, `string uid`, ``, missingOutputOnly},
+		{"InvalidUidBehavior", messageOptsResource, `string uid`, `[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_IMMUTABLE]`, missingOutputOnly},
 		{"ValidUpdateTime", messageOptsResource, `google.protobuf.Timestamp update_time`, fieldOptsOutputOnly, nil},
 		{"InvalidUpdateTime", messageOptsResource, `google.protobuf.Timestamp update_time`, ``, missingOutputOnly},
 		{"IrrelevantNotResource", ``, `string uid`, ``, nil},
 		{"IrrelevantField", messageOptsResource, `string name`, ``, nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/field_info.proto";
 				import "aep/api/resource.proto";
 				import "google/protobuf/timestamp.proto";
@@ -60,3 +62,25 @@ func TestFieldBehavior(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldBehavior_Location(t *testing.T) {
+	file := ruletest.ParseProto3String(t, `
+		import "aep/api/field_info.proto";
+		import "aep/api/resource.proto";
+		message Book {
+			option (aep.api.resource).type = "library.googleapis.com/Book";
+			string uid = 1;
+			string create_time = 2 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_IMMUTABLE];
+		}
+	`)
+	// A field without behaviors keeps the location of the field, and one
+	// with other behaviors is located at its options.
+	want := [][]int32{nil, {7, 31, 95}}
+	var got [][]int32
+	for _, p := range fieldBehavior.Lint(file) {
+		got = append(got, p.Location.GetSpan())
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Location spans mismatch (-want +got):\n%s", diff)
+	}
+}
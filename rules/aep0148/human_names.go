@@ -25,6 +25,7 @@ import (
 var humanNames = &lint.FieldRule{
 	Name:     lint.NewRuleName(148, "human-names"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
 		for got, want := range corrections {
 			if f.GetName() == got {
@@ -17,21 +17,21 @@ package aep0148
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHumanNames(t *testing.T) {
 	for _, test := range []struct {
 		FieldName string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
 		{"given_name", nil},
 		{"family_name", nil},
-		{"first_name", testutils.Problems{{Suggestion: "given_name"}}},
-		{"last_name", testutils.Problems{{Suggestion: "family_name"}}},
+		{"first_name", ruletest.Problems{{Suggestion: "given_name"}}},
+		{"last_name", ruletest.Problems{{Suggestion: "family_name"}}},
 	} {
 		t.Run(test.FieldName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message Person {
 					string {{.FieldName}} = 1;
 				}
@@ -17,8 +17,8 @@ package aep0148
 import (
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/types/descriptorpb"
@@ -37,7 +37,7 @@ var ipAddressFormat = &lint.FieldRule{
 		return fd.GetType() == descriptorpb.FieldDescriptorProto_TYPE_STRING && (fd.GetName() == "ip_address" || strings.HasSuffix(fd.GetName(), "_ip_address"))
 	},
 	LintField: func(fd *desc.FieldDescriptor) []lint.Problem {
-		if !utils.HasFormat(fd) || !oneofFormats(utils.GetFormat(fd), ipAddressFormats) {
+		if !descutil.HasFormat(fd) || !oneofFormats(descutil.GetFormat(fd), ipAddressFormats) {
 			return []lint.Problem{{
 				Message:    "IP Address fields must specify one of the `(google.api.field_info).format` values `IPV4`, `IPV6`, or `IPV4_OR_IPV6`",
 				Descriptor: fd,
@@ -17,13 +17,13 @@ package aep0148
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestIpAddressFormat(t *testing.T) {
 	for _, test := range []struct {
 		name, Annotation, FieldName, Type string
-		problems                          testutils.Problems
+		problems                          ruletest.Problems
 	}{
 		{
 			name:       "ValidIpAddressFormat",
@@ -56,18 +56,18 @@ func TestIpAddressFormat(t *testing.T) {
 			name:      "InvalidMissingFormat",
 			FieldName: "ip_address",
 			Type:      "string",
-			problems:  testutils.Problems{{Message: "must specify one of"}},
+			problems:  ruletest.Problems{{Message: "must specify one of"}},
 		},
 		{
 			name:       "InvalidWrongFormat",
 			FieldName:  "ip_address",
 			Annotation: "[(google.api.field_info).format = UUID4]",
 			Type:       "string",
-			problems:   testutils.Problems{{Message: "must specify one of"}},
+			problems:   ruletest.Problems{{Message: "must specify one of"}},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/field_info.proto";
 
 				message Person {
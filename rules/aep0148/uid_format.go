@@ -15,8 +15,8 @@
 package aep0148
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/types/descriptorpb"
@@ -31,7 +31,7 @@ var uidFormat = &lint.FieldRule{
 		return fd.GetType() == descriptorpb.FieldDescriptorProto_TYPE_STRING && fd.GetName() == uidStr
 	},
 	LintField: func(fd *desc.FieldDescriptor) []lint.Problem {
-		if !utils.HasFormat(fd) || utils.GetFormat(fd) != annotations.FieldInfo_UUID4 {
+		if !descutil.HasFormat(fd) || descutil.GetFormat(fd) != annotations.FieldInfo_UUID4 {
 			return []lint.Problem{{
 				Message:    "The `uid` field must have a `(google.api.field_info).format = UUID4` annotation.",
 				Descriptor: fd,
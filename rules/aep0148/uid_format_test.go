@@ -17,13 +17,13 @@ package aep0148
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestUidFormat(t *testing.T) {
 	for _, test := range []struct {
 		name, Annotation, FieldName, Type string
-		problems                          testutils.Problems
+		problems                          ruletest.Problems
 	}{
 		{
 			name:       "ValidUidFormat",
@@ -45,18 +45,18 @@ func TestUidFormat(t *testing.T) {
 			name:      "InvalidMissingFormat",
 			FieldName: "uid",
 			Type:      "string",
-			problems:  testutils.Problems{{Message: "format = UUID4"}},
+			problems:  ruletest.Problems{{Message: "format = UUID4"}},
 		},
 		{
 			name:       "InvalidWrongFormat",
 			FieldName:  "uid",
 			Annotation: "[(google.api.field_info).format = IPV4]",
 			Type:       "string",
-			problems:   testutils.Problems{{Message: "format = UUID4"}},
+			problems:   ruletest.Problems{{Message: "format = UUID4"}},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/field_info.proto";
 
 				message Person {
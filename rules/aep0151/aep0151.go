@@ -16,8 +16,8 @@
 package aep0151
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -39,5 +39,5 @@ func isLRO(m *desc.MethodDescriptor) bool {
 }
 
 func isAnnotatedLRO(m *desc.MethodDescriptor) bool {
-	return isLRO(m) && utils.GetOperationInfo(m) != nil
+	return isLRO(m) && descutil.GetOperationInfo(m) != nil
 }
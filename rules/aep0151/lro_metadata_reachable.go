@@ -15,8 +15,8 @@
 package aep0151
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -26,6 +26,6 @@ var lroMetadataReachable = &lint.MethodRule{
 	OnlyIf:   isAnnotatedLRO,
 	LintMethod: func(m *desc.MethodDescriptor) (problems []lint.Problem) {
 		// See lro_response_reachable.go for `checkReachable` method.
-		return checkReachable(m, utils.GetOperationInfo(m).GetMetadataType())
+		return checkReachable(m, descutil.GetOperationInfo(m).GetMetadataType())
 	},
 }
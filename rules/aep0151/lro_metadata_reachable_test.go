@@ -18,7 +18,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestLROMetadataReachable(t *testing.T) {
@@ -27,18 +27,18 @@ func TestLROMetadataReachable(t *testing.T) {
 			testName     string
 			Package      string
 			MetadataType string
-			problems     testutils.Problems
+			problems     ruletest.Problems
 		}{
-			{"Valid", "", "WriteBookMetadata", testutils.Problems{}},
-			{"ValidPkg", "package test;", "WriteBookMetadata", testutils.Problems{}},
-			{"InvalidTypo", "", "WriteBookMeta", testutils.Problems{{Message: "WriteBookMeta"}}},
-			{"InvalidTypoPkg", "package test;", "WriteBookMeta", testutils.Problems{{Message: "test.WriteBookMeta"}}},
-			{"ValidExternal", "", "google.protobuf.Empty", testutils.Problems{}},
-			{"ValidExternalPkg", "package test;", "google.protobuf.Empty", testutils.Problems{}},
+			{"Valid", "", "WriteBookMetadata", ruletest.Problems{}},
+			{"ValidPkg", "package test;", "WriteBookMetadata", ruletest.Problems{}},
+			{"InvalidTypo", "", "WriteBookMeta", ruletest.Problems{{Message: "WriteBookMeta"}}},
+			{"InvalidTypoPkg", "package test;", "WriteBookMeta", ruletest.Problems{{Message: "test.WriteBookMeta"}}},
+			{"ValidExternal", "", "google.protobuf.Empty", ruletest.Problems{}},
+			{"ValidExternalPkg", "package test;", "google.protobuf.Empty", ruletest.Problems{}},
 		}
 		for _, test := range tests {
 			t.Run(test.testName, func(t *testing.T) {
-				f := testutils.ParseProto3Tmpl(t, `
+				f := ruletest.ParseProto3Tmpl(t, `
 					{{.Package}}
 					import "google/longrunning/operations.proto";
 					service Library {
@@ -65,13 +65,13 @@ func TestLROMetadataReachable(t *testing.T) {
 		for _, test := range []struct {
 			name     string
 			message  string
-			problems testutils.Problems
+			problems ruletest.Problems
 		}{
-			{"Present", "message WriteBookMetadata {}", testutils.Problems{}},
-			{"Absent", "", testutils.Problems{{Message: "WriteBookMetadata"}}},
+			{"Present", "message WriteBookMetadata {}", ruletest.Problems{}},
+			{"Absent", "", ruletest.Problems{{Message: "WriteBookMetadata"}}},
 		} {
 			t.Run(test.name, func(t *testing.T) {
-				files := testutils.ParseProtoStrings(t, map[string]string{
+				files := ruletest.ParseProtoStrings(t, map[string]string{
 					"imported.proto": strings.ReplaceAll(`
 						syntax = "proto3";
 						message WriteBookRequest {}
@@ -15,9 +15,9 @@
 package aep0151
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -26,7 +26,7 @@ var lroMetadata = &lint.MethodRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf:   isAnnotatedLRO,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		lro := utils.GetOperationInfo(m)
+		lro := descutil.GetOperationInfo(m)
 
 		// Ensure the response type is set.
 		if lro.GetMetadataType() == "" {
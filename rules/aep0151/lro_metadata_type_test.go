@@ -17,7 +17,7 @@ package aep0151
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestLROMetadata(t *testing.T) {
@@ -25,16 +25,16 @@ func TestLROMetadata(t *testing.T) {
 		testName   string
 		MethodName string
 		Metadata   string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"Valid", "WriteBook", "WriteBookMetadata", testutils.Problems{}},
-		{"InvalidEmptyString", "WriteBook", "", testutils.Problems{{Message: "must set the metadata type"}}},
-		{"InvalidGPEmpty", "WriteBook", "google.protobuf.Empty", testutils.Problems{{Message: "Empty"}}},
-		{"InvalidGPEmptyDelete", "DeleteBook", "google.protobuf.Empty", testutils.Problems{{Message: "Empty"}}},
+		{"Valid", "WriteBook", "WriteBookMetadata", ruletest.Problems{}},
+		{"InvalidEmptyString", "WriteBook", "", ruletest.Problems{{Message: "must set the metadata type"}}},
+		{"InvalidGPEmpty", "WriteBook", "google.protobuf.Empty", ruletest.Problems{{Message: "Empty"}}},
+		{"InvalidGPEmptyDelete", "DeleteBook", "google.protobuf.Empty", ruletest.Problems{{Message: "Empty"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/longrunning/operations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request)
@@ -18,9 +18,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -29,7 +29,7 @@ var lroResponseReachable = &lint.MethodRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf:   isAnnotatedLRO,
 	LintMethod: func(m *desc.MethodDescriptor) (problems []lint.Problem) {
-		return checkReachable(m, utils.GetOperationInfo(m).GetResponseType())
+		return checkReachable(m, descutil.GetOperationInfo(m).GetResponseType())
 	},
 }
 
@@ -46,7 +46,7 @@ func checkReachable(m *desc.MethodDescriptor, name string) []lint.Problem {
 	}
 
 	// If the message is defined in the file, we are good to go.
-	for _, file := range utils.GetAllDependencies(f) {
+	for _, file := range descutil.GetAllDependencies(f) {
 		if file.FindMessage(name) != nil {
 			return nil
 		}
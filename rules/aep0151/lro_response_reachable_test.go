@@ -18,7 +18,7 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestLROResponseReachable(t *testing.T) {
@@ -27,18 +27,18 @@ func TestLROResponseReachable(t *testing.T) {
 			testName     string
 			Package      string
 			ResponseType string
-			problems     testutils.Problems
+			problems     ruletest.Problems
 		}{
-			{"Valid", "", "WriteBookResponse", testutils.Problems{}},
-			{"ValidPkg", "package test;", "WriteBookResponse", testutils.Problems{}},
-			{"InvalidTypo", "", "WriteBookReponse", testutils.Problems{{Message: "WriteBookReponse"}}},
-			{"InvalidTypoPkg", "package test;", "WriteBookReponse", testutils.Problems{{Message: "test.WriteBookReponse"}}},
-			{"ValidExternal", "", "google.protobuf.Empty", testutils.Problems{}},
-			{"ValidExternalPkg", "package test;", "google.protobuf.Empty", testutils.Problems{}},
+			{"Valid", "", "WriteBookResponse", ruletest.Problems{}},
+			{"ValidPkg", "package test;", "WriteBookResponse", ruletest.Problems{}},
+			{"InvalidTypo", "", "WriteBookReponse", ruletest.Problems{{Message: "WriteBookReponse"}}},
+			{"InvalidTypoPkg", "package test;", "WriteBookReponse", ruletest.Problems{{Message: "test.WriteBookReponse"}}},
+			{"ValidExternal", "", "google.protobuf.Empty", ruletest.Problems{}},
+			{"ValidExternalPkg", "package test;", "google.protobuf.Empty", ruletest.Problems{}},
 		}
 		for _, test := range tests {
 			t.Run(test.testName, func(t *testing.T) {
-				f := testutils.ParseProto3Tmpl(t, `
+				f := ruletest.ParseProto3Tmpl(t, `
 					{{.Package}}
 					import "google/longrunning/operations.proto";
 					service Library {
@@ -65,13 +65,13 @@ func TestLROResponseReachable(t *testing.T) {
 		for _, test := range []struct {
 			name     string
 			message  string
-			problems testutils.Problems
+			problems ruletest.Problems
 		}{
-			{"Present", "message WriteBookResponse {}", testutils.Problems{}},
-			{"Absent", "", testutils.Problems{{Message: "WriteBookResponse"}}},
+			{"Present", "message WriteBookResponse {}", ruletest.Problems{}},
+			{"Absent", "", ruletest.Problems{{Message: "WriteBookResponse"}}},
 		} {
 			t.Run(test.name, func(t *testing.T) {
-				files := testutils.ParseProtoStrings(t, map[string]string{
+				files := ruletest.ParseProtoStrings(t, map[string]string{
 					"imported.proto": strings.ReplaceAll(`
 						syntax = "proto3";
 						message WriteBookRequest {}
@@ -104,13 +104,13 @@ func TestLROResponseReachable(t *testing.T) {
 		for _, test := range []struct {
 			name     string
 			message  string
-			problems testutils.Problems
+			problems ruletest.Problems
 		}{
-			{"Present", "message WriteBookResponse {}", testutils.Problems{}},
-			{"Absent", "", testutils.Problems{{Message: "WriteBookResponse"}}},
+			{"Present", "message WriteBookResponse {}", ruletest.Problems{}},
+			{"Absent", "", ruletest.Problems{{Message: "WriteBookResponse"}}},
 		} {
 			t.Run(test.name, func(t *testing.T) {
-				files := testutils.ParseProtoStrings(t, map[string]string{
+				files := ruletest.ParseProtoStrings(t, map[string]string{
 					"transitive.proto": strings.ReplaceAll(`
 						syntax = "proto3";
 						---
@@ -19,8 +19,8 @@ import (
 
 	"github.com/aep-dev/api-linter/locations"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -29,7 +29,7 @@ var lroResponse = &lint.MethodRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf:   isAnnotatedLRO,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		lro := utils.GetOperationInfo(m)
+		lro := descutil.GetOperationInfo(m)
 
 		// Ensure the response type is set.
 		if lro.GetResponseType() == "" {
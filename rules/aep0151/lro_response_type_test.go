@@ -17,7 +17,7 @@ package aep0151
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestLROResponse(t *testing.T) {
@@ -25,17 +25,17 @@ func TestLROResponse(t *testing.T) {
 		testName   string
 		MethodName string
 		Response   string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"Valid", "WriteBook", "WriteBookResponse", testutils.Problems{}},
-		{"InvalidEmptyString", "WriteBook", "", testutils.Problems{{Message: "must set the response type"}}},
-		{"InvalidGPEmpty", "WriteBook", "google.protobuf.Empty", testutils.Problems{{Message: "Empty"}}},
-		{"ValidGPEmptyDelete", "DeleteBook", "google.protobuf.Empty", testutils.Problems{}},
-		{"ValidGPEmptyDelete", "BatchDeleteBooks", "google.protobuf.Empty", testutils.Problems{}},
+		{"Valid", "WriteBook", "WriteBookResponse", ruletest.Problems{}},
+		{"InvalidEmptyString", "WriteBook", "", ruletest.Problems{{Message: "must set the response type"}}},
+		{"InvalidGPEmpty", "WriteBook", "google.protobuf.Empty", ruletest.Problems{{Message: "Empty"}}},
+		{"ValidGPEmptyDelete", "DeleteBook", "google.protobuf.Empty", ruletest.Problems{}},
+		{"ValidGPEmptyDelete", "BatchDeleteBooks", "google.protobuf.Empty", ruletest.Problems{}},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/longrunning/operations.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request)
@@ -15,8 +15,8 @@
 package aep0151
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -25,7 +25,7 @@ var lroAnnotationExists = &lint.MethodRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf:   isLRO,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		if utils.GetOperationInfo(m) == nil {
+		if descutil.GetOperationInfo(m) == nil {
 			return []lint.Problem{{
 				Message:    "Methods returning an LRO must include the operation_info annotation.",
 				Descriptor: m,
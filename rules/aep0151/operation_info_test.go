@@ -17,11 +17,11 @@ package aep0151
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestAnnotationExistsValid(t *testing.T) {
-	f := testutils.ParseProto3String(t, `
+	f := ruletest.ParseProto3String(t, `
 		import "google/longrunning/operations.proto";
 		service Library {
 			rpc WriteBook(WriteBookRequest) returns (google.longrunning.Operation) {
@@ -33,20 +33,20 @@ func TestAnnotationExistsValid(t *testing.T) {
 		}
 		message WriteBookRequest {}
 	`)
-	if diff := (testutils.Problems{}).Diff(lroAnnotationExists.Lint(f)); diff != "" {
+	if diff := (ruletest.Problems{}).Diff(lroAnnotationExists.Lint(f)); diff != "" {
 		t.Error(diff)
 	}
 }
 
 func TestAnnotationExistsInvalid(t *testing.T) {
-	f := testutils.ParseProto3String(t, `
+	f := ruletest.ParseProto3String(t, `
 		import "google/longrunning/operations.proto";
 		service Library {
 			rpc WriteBook(WriteBookRequest) returns (google.longrunning.Operation);
 		}
 		message WriteBookRequest {}
 	`)
-	want := testutils.Problems{{
+	want := ruletest.Problems{{
 		Descriptor: f.GetServices()[0].GetMethods()[0],
 		Message:    "operation_info annotation",
 	}}
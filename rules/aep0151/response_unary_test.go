@@ -17,7 +17,7 @@ package aep0151
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResponseUnary(t *testing.T) {
@@ -25,14 +25,14 @@ func TestResponseUnary(t *testing.T) {
 		name     string
 		Stream   string
 		Response string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Vaild", "", "google.longrunning.Operation", nil},
-		{"Invalid", "stream ", "google.longrunning.Operation", testutils.Problems{{Message: "unary, not stream"}}},
+		{"Invalid", "stream ", "google.longrunning.Operation", ruletest.Problems{{Message: "unary, not stream"}}},
 		{"Irrelevant", "stream ", "ReadBookResponse", nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "google/longrunning/operations.proto";
 				service Library {
 					rpc ReadBook(ReadBookRequest) returns ({{.Stream}}{{.Response}});
@@ -17,13 +17,13 @@ package aep0155
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestIdFormat(t *testing.T) {
 	for _, test := range []struct {
 		name, FieldName, Type string
-		problems              testutils.Problems
+		problems              ruletest.Problems
 	}{
 		{
 			name:      "ValidRequestIdFormat",
@@ -39,11 +39,11 @@ func TestRequestIdFormat(t *testing.T) {
 			name:      "InvalidType",
 			FieldName: "request_id",
 			Type:      "string",
-			problems:  testutils.Problems{{Message: "type `aep.api.IdempotencyKey`"}},
+			problems:  ruletest.Problems{{Message: "type `aep.api.IdempotencyKey`"}},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/idempotency_key.proto";
 				message Person {
 					{{.Type}} {{.FieldName}} = 2;
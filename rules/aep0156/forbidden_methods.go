@@ -18,8 +18,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -32,7 +32,7 @@ var forbiddenMethods = &lint.MethodRule{
 		// For example:
 		//   publishers/*/books/* -- not a singleton, many books
 		//   publishers/*/settings -- a singleton; one settings object per publisher
-		for _, http := range utils.GetHTTPRules(m) {
+		for _, http := range descutil.GetHTTPRules(m) {
 			if path, ok := http.GetVariables()["path"]; ok {
 				if !strings.HasSuffix(path, "*") {
 					return true
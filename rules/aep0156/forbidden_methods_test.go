@@ -17,7 +17,7 @@ package aep0156
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestForbiddenMethods(t *testing.T) {
@@ -25,16 +25,16 @@ func TestForbiddenMethods(t *testing.T) {
 		name       string
 		MethodName string
 		Suffix     string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
-		{"ValidGet", "GetPublisherSettings", "/settings", testutils.Problems{}},
-		{"ValidUpdate", "UpdatePublisherSettings", "/settings", testutils.Problems{}},
-		{"ValidList", "ListPublisherSettings", "/settings", testutils.Problems{}},
-		{"InvalidCreate", "CreatePublisherSettings", "/settings", testutils.Problems{{Message: "Create"}}},
-		{"InvalidDelete", "DeletePublisherSettings", "/settings", testutils.Problems{{Message: "Delete"}}},
-		{"Irrelevant", "CreatePublisher", "", testutils.Problems{}},
+		{"ValidGet", "GetPublisherSettings", "/settings", ruletest.Problems{}},
+		{"ValidUpdate", "UpdatePublisherSettings", "/settings", ruletest.Problems{}},
+		{"ValidList", "ListPublisherSettings", "/settings", ruletest.Problems{}},
+		{"InvalidCreate", "CreatePublisherSettings", "/settings", ruletest.Problems{{Message: "Create"}}},
+		{"InvalidDelete", "DeletePublisherSettings", "/settings", ruletest.Problems{{Message: "Delete"}}},
+		{"Irrelevant", "CreatePublisher", "", ruletest.Problems{}},
 	} {
-		f := testutils.ParseProto3Tmpl(t, `
+		f := ruletest.ParseProto3Tmpl(t, `
 			import "google/api/annotations.proto";
 			service Library {
 				rpc {{.MethodName}}({{.MethodName}}Request) returns (Settings) {
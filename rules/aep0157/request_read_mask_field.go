@@ -15,16 +15,17 @@
 package aep0157
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestReadMaskField = &lint.FieldRule{
 	Name:     lint.NewRuleName(157, "request-read-mask-field"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
 		return isRequestMessage(f.GetOwner()) && f.GetName() == "read_mask"
 	},
-	LintField: utils.LintFieldMask,
+	LintField: descutil.LintFieldMask,
 }
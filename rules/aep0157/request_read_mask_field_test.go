@@ -17,7 +17,7 @@ package aep0157
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestFieldMaskField(t *testing.T) {
@@ -26,17 +26,17 @@ func TestRequestFieldMaskField(t *testing.T) {
 		MessageName string
 		FieldType   string
 		FieldName   string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "FooRequest", "google.protobuf.FieldMask", "read_mask", nil},
-		{"InvalidType", "FooRequest", "string", "read_mask", testutils.Problems{{Suggestion: "google.protobuf.FieldMask"}}},
-		{"InvalidRepeated", "FooRequest", "repeated google.protobuf.FieldMask", "read_mask", testutils.Problems{{Suggestion: "google.protobuf.FieldMask"}}},
+		{"InvalidType", "FooRequest", "string", "read_mask", ruletest.Problems{{Suggestion: "google.protobuf.FieldMask"}}},
+		{"InvalidRepeated", "FooRequest", "repeated google.protobuf.FieldMask", "read_mask", ruletest.Problems{{Suggestion: "google.protobuf.FieldMask"}}},
 		{"IrrelevantMessage", "Foo", "string", "read_mask", nil},
 		{"IrrelevantField", "FooRequest", "string", "bar", nil},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/protobuf/field_mask.proto";
 				message {{.MessageName}} {
 					{{.FieldType}} {{.FieldName}} = 1;
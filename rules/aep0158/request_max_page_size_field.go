@@ -15,8 +15,8 @@
 package aep0158
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 )
@@ -24,16 +24,17 @@ import (
 var requestPaginationMaxPageSize = &lint.MessageRule{
 	Name:     lint.NewRuleName(158, "request-max-page-size-field"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf:   isPaginatedRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		f, problems := utils.LintFieldPresent(m, "max_page_size")
+		f, problems := descutil.LintFieldPresent(m, "max_page_size")
 		if len(problems) > 0 {
 			return problems
 		}
 		// Checks that page_size is of type int32 and is not a oneof. These are
 		// noops if page_size is not a oneof and is a int32.
-		problems = append(problems, utils.LintSingularField(f, builder.FieldTypeInt32(), "int32")...)
-		problems = append(problems, utils.LintNotOneof(f)...)
+		problems = append(problems, descutil.LintSingularField(f, builder.FieldTypeInt32(), "int32")...)
+		problems = append(problems, descutil.LintNotOneof(f)...)
 
 		return problems
 	},
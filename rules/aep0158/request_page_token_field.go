@@ -15,24 +15,25 @@
 package aep0158
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var requestPaginationPageToken = &lint.MessageRule{
 	Name:     lint.NewRuleName(158, "request-page-token-field"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf:   isPaginatedRequestMessage,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		f, problems := utils.LintFieldPresent(m, "page_token")
+		f, problems := descutil.LintFieldPresent(m, "page_token")
 		if len(problems) > 0 {
 			return problems
 		}
 		// Checks that page_size is of type string and is not a oneof. These are
 		// noops if page_size is not a oneof and is a string.
-		problems = append(problems, utils.LintSingularStringField(f)...)
-		problems = append(problems, utils.LintNotOneof(f)...)
+		problems = append(problems, descutil.LintSingularStringField(f)...)
+		problems = append(problems, descutil.LintNotOneof(f)...)
 
 		return problems
 	},
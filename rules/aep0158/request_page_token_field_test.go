@@ -18,7 +18,7 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 )
@@ -30,7 +30,7 @@ func TestRequestPaginationPageToken(t *testing.T) {
 		messageName   string
 		messageFields []field
 		isOneof       bool
-		problems      testutils.Problems
+		problems      ruletest.Problems
 		problemDesc   func(m *desc.MessageDescriptor) desc.Descriptor
 	}{
 		{
@@ -38,7 +38,7 @@ func TestRequestPaginationPageToken(t *testing.T) {
 			"ListFooRequest",
 			[]field{{"page_token", builder.FieldTypeString()}},
 			false,
-			testutils.Problems{},
+			ruletest.Problems{},
 			nil,
 		},
 		{
@@ -46,7 +46,7 @@ func TestRequestPaginationPageToken(t *testing.T) {
 			"ListFooRequest",
 			[]field{{"name", builder.FieldTypeString()}},
 			false,
-			testutils.Problems{{Message: "page_token"}},
+			ruletest.Problems{{Message: "page_token"}},
 			nil,
 		},
 		{
@@ -54,7 +54,7 @@ func TestRequestPaginationPageToken(t *testing.T) {
 			"ListFooRequest",
 			[]field{{"page_token", builder.FieldTypeDouble()}},
 			false,
-			testutils.Problems{{Suggestion: "string"}},
+			ruletest.Problems{{Suggestion: "string"}},
 			func(m *desc.MessageDescriptor) desc.Descriptor {
 				return m.FindFieldByName("page_token")
 			},
@@ -72,7 +72,7 @@ func TestRequestPaginationPageToken(t *testing.T) {
 			"ListFooRequest",
 			[]field{{"page_token", builder.FieldTypeString()}},
 			/* isOneof */ true,
-			testutils.Problems{{Message: "oneof"}},
+			ruletest.Problems{{Message: "oneof"}},
 			func(m *desc.MessageDescriptor) desc.Descriptor {
 				return m.FindFieldByName("page_token")
 			},
@@ -24,6 +24,7 @@ import (
 var requestSkipField = &lint.FieldRule{
 	Name:     lint.NewRuleName(158, "request-skip-field"),
 	RuleType: lint.NewRuleType(lint.MayRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
 		return isPaginatedRequestMessage(f.GetOwner()) && f.GetName() == "skip"
 	},
@@ -17,7 +17,7 @@ package aep0158
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -26,19 +26,19 @@ func TestRequestSkipField(t *testing.T) {
 		name        string
 		MessageName string
 		Fields      string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"ValidPresent", "FooRequest", `int32 page_size = 1; int32 skip = 2;`, nil},
 		{"ValidAbsent", "FooRequest", `int32 page_size = 1;`, nil},
-		{"InvalidType", "FooRequest", `int32 page_size = 1; string skip = 2;`, testutils.Problems{{Suggestion: "int32"}}},
-		{"InvalidRepeated", "FooRequest", `int32 page_size = 1; repeated int32 skip = 2;`, testutils.Problems{{Suggestion: "int32"}}},
+		{"InvalidType", "FooRequest", `int32 page_size = 1; string skip = 2;`, ruletest.Problems{{Suggestion: "int32"}}},
+		{"InvalidRepeated", "FooRequest", `int32 page_size = 1; repeated int32 skip = 2;`, ruletest.Problems{{Suggestion: "int32"}}},
 		{"IrrelevantMessageName", "Foo", `int32 page_size = 1; string skip = 2;`, nil},
 		{"IrrelevantMessageFields", "FooRequest", `string skip = 1;`, nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					{{.Fields}}
 				}
@@ -15,13 +15,14 @@
 package aep0158
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 var responsePaginationNextPageToken = &lint.MessageRule{
 	Name:        lint.NewRuleName(158, "response-next-page-token-field"),
 	RuleType:    lint.NewRuleType(lint.MustRule),
+	Metadata:    lint.RuleMetadata{Fixable: true},
 	OnlyIf:      isPaginatedResponseMessage,
-	LintMessage: utils.LintFieldPresentAndSingularString("next_page_token"),
+	LintMessage: descutil.LintFieldPresentAndSingularString("next_page_token"),
 }
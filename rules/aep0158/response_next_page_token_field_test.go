@@ -17,7 +17,7 @@ package aep0158
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 )
@@ -33,28 +33,28 @@ func TestResponsePaginationNextPageToken(t *testing.T) {
 		testName      string
 		messageName   string
 		messageFields []field
-		problems      testutils.Problems
+		problems      ruletest.Problems
 		problemDesc   func(m *desc.MessageDescriptor) desc.Descriptor
 	}{
 		{
 			"Valid",
 			"ListBooksResponse",
 			[]field{{"books", builder.FieldTypeString()}, {"next_page_token", builder.FieldTypeString()}},
-			testutils.Problems{},
+			ruletest.Problems{},
 			nil,
 		},
 		{
 			"MissingField",
 			"ListBooksResponse",
 			[]field{{"books", builder.FieldTypeString()}},
-			testutils.Problems{{Message: "next_page_token"}},
+			ruletest.Problems{{Message: "next_page_token"}},
 			nil,
 		},
 		{
 			"InvalidType",
 			"ListFooResponse",
 			[]field{{"name", builder.FieldTypeString()}, {"next_page_token", builder.FieldTypeDouble()}},
-			testutils.Problems{{Suggestion: "string"}},
+			ruletest.Problems{{Suggestion: "string"}},
 			func(m *desc.MessageDescriptor) desc.Descriptor {
 				return m.FindFieldByName("next_page_token")
 			},
@@ -17,7 +17,7 @@ package aep0158
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResponseRepeatedFirstField(t *testing.T) {
@@ -25,17 +25,17 @@ func TestResponseRepeatedFirstField(t *testing.T) {
 		testName    string
 		FirstField  string
 		SecondField string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "repeated Book books = 1;", "string next_page_token = 2;", nil},
 		{"SkippedZeroFields", "", "", nil},
 		{"ProtobufIdIsNotOne", "string next_page_token = 1;", "repeated Book books = 2;", nil},
-		{"InvalidNotRepeated", "Book book = 1;", "string next_page_token = 2;", testutils.Problems{{Message: "repeated"}}},
+		{"InvalidNotRepeated", "Book book = 1;", "string next_page_token = 2;", ruletest.Problems{{Message: "repeated"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
 			// Create the proto message.
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message Book {
 					string name = 1;
 				}
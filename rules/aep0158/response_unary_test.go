@@ -17,7 +17,7 @@ package aep0158
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResponseUnary(t *testing.T) {
@@ -26,14 +26,14 @@ func TestResponseUnary(t *testing.T) {
 		Stream        string
 		Response      string
 		NextPageToken string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
 		{"Vaild", "", "ListFoosResponse", "next_page_token", nil},
-		{"Invalid", "stream ", "ListFoosResponse", "next_page_token", testutils.Problems{{Message: "unary, not stream"}}},
+		{"Invalid", "stream ", "ListFoosResponse", "next_page_token", ruletest.Problems{{Message: "unary, not stream"}}},
 		{"Irrelevant", "stream ", "FrobFoosResponse", "not_paginated_response", nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				service Foos {
 					rpc ListFoos(ListFoosRequest) returns ({{.Stream}}{{.Response}});
 				}
@@ -17,9 +17,9 @@ package aep0159
 import (
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,7 +27,7 @@ var hardcodedHyphen = &lint.MethodRule{
 	Name:     lint.NewRuleName(159, "hardcoded-hyphen"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, http := range utils.GetHTTPRules(m) {
+		for _, http := range descutil.GetHTTPRules(m) {
 			if strings.Contains(http.GetPlainURI(), "/-/") {
 				return []lint.Problem{{
 					Message:    "URIs must not hard-code a `-` segment.",
@@ -17,19 +17,19 @@ package aep0159
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHardcodedHyphen(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		Parent   string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "publishers/*", testutils.Problems{}},
-		{"Invalid", "publishers/-", testutils.Problems{{Message: "`-`"}}},
+		{"Valid", "publishers/*", ruletest.Problems{}},
+		{"Invalid", "publishers/-", ruletest.Problems{{Message: "`-`"}}},
 	} {
-		f := testutils.ParseProto3Tmpl(t, `
+		f := ruletest.ParseProto3Tmpl(t, `
 			import "google/api/annotations.proto";
 			service Library {
 				rpc ListBooks(ListBooksRequest) returns (ListBooksResponse) {
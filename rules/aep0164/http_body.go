@@ -15,13 +15,13 @@
 package aep0164
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Undelete methods should have "*" as the HTTP body.
 var httpBody = &lint.MethodRule{
 	Name:       lint.NewRuleName(164, "http-body"),
 	OnlyIf:     isUndeleteMethod,
-	LintMethod: utils.LintWildcardHTTPBody,
+	LintMethod: descutil.LintWildcardHTTPBody,
 }
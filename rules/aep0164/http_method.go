@@ -15,13 +15,13 @@
 package aep0164
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Undelete methods should use the HTTP POST method.
 var httpMethod = &lint.MethodRule{
 	Name:       lint.NewRuleName(164, "http-method"),
 	OnlyIf:     isUndeleteMethod,
-	LintMethod: utils.LintHTTPMethod("POST"),
+	LintMethod: descutil.LintHTTPMethod("POST"),
 }
@@ -15,9 +15,9 @@
 package aep0164
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -26,7 +26,7 @@ var httpURISuffix = &lint.MethodRule{
 	Name:   lint.NewRuleName(164, "http-uri-suffix"),
 	OnlyIf: isUndeleteMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for _, httpRule := range descutil.GetHTTPRules(m) {
 			if !undeleteURINameRegexp.MatchString(httpRule.URI) {
 				return []lint.Problem{{
 					Message:    `Undelete URI should end with ":undelete".`,
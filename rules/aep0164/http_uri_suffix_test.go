@@ -17,7 +17,7 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestHttpUriSuffix(t *testing.T) {
@@ -25,16 +25,16 @@ func TestHttpUriSuffix(t *testing.T) {
 		testName   string
 		HTTPURI    string
 		MethodName string
-		problems   testutils.Problems
+		problems   ruletest.Problems
 	}{
 		{"Valid", "/v1/{name=publishers/*/books/*}:undelete", "UndeleteBook", nil},
-		{"InvalidSuffix", "/v1/{name=publishers/*/books/*}", "UndeleteBook", testutils.Problems{{Message: ":undelete"}}},
+		{"InvalidSuffix", "/v1/{name=publishers/*/books/*}", "UndeleteBook", ruletest.Problems{{Message: ":undelete"}}},
 		{"Irrelevant", "/v1/{book=publishers/*/books/*}", "AcquireBook", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/api/annotations.proto";
 
 				service BookService {
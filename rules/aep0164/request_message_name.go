@@ -15,13 +15,14 @@
 package aep0164
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 // Undelete messages should have a properly named request message.
 var requestMessageName = &lint.MethodRule{
 	Name:       lint.NewRuleName(164, "request-message-name"),
+	Metadata:   lint.RuleMetadata{Fixable: true},
 	OnlyIf:     isUndeleteMethod,
-	LintMethod: utils.LintMethodHasMatchingRequestName,
+	LintMethod: descutil.LintMethodHasMatchingRequestName,
 }
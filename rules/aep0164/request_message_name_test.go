@@ -17,7 +17,7 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestMessageName(t *testing.T) {
@@ -26,17 +26,17 @@ func TestRequestMessageName(t *testing.T) {
 		testName       string
 		MethodName     string
 		ReqMessageName string
-		problems       testutils.Problems
+		problems       ruletest.Problems
 	}{
-		{"Valid", "UndeleteBook", "UndeleteBookRequest", testutils.Problems{}},
-		{"Invalid", "UndeleteBook", "Book", testutils.Problems{{Suggestion: "UndeleteBookRequest"}}},
-		{"Irrelevant", "AcquireBook", "Book", testutils.Problems{}},
+		{"Valid", "UndeleteBook", "UndeleteBookRequest", ruletest.Problems{}},
+		{"Invalid", "UndeleteBook", "Book", ruletest.Problems{{Suggestion: "UndeleteBookRequest"}}},
+		{"Irrelevant", "AcquireBook", "Book", ruletest.Problems{}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				service Library {
 					rpc {{.MethodName}}({{.ReqMessageName}}) returns (Book) {}
 				}
@@ -15,8 +15,8 @@
 package aep0164
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -25,5 +25,5 @@ var requestNameBehavior = &lint.FieldRule{
 	OnlyIf: func(f *desc.FieldDescriptor) bool {
 		return isUndeleteRequestMessage(f.GetOwner()) && f.GetName() == "name"
 	},
-	LintField: utils.LintRequiredField,
+	LintField: descutil.LintRequiredField,
 }
@@ -17,7 +17,7 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestNameBehavior(t *testing.T) {
@@ -26,15 +26,15 @@ func TestRequestNameBehavior(t *testing.T) {
 		MessageName   string
 		FieldName     string
 		FieldBehavior string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
-		{"Valid", "UndeleteBookRequest", "name", " [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED]", testutils.Problems{}},
-		{"Missing", "UndeleteBookRequest", "name", "", testutils.Problems{{Message: "(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED"}}},
-		{"IrrelevantMessage", "AcquireBookRequest", "name", "", testutils.Problems{}},
-		{"IrrelevantField", "UndeleteBookRequest", "something_else", "", testutils.Problems{}},
+		{"Valid", "UndeleteBookRequest", "name", " [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED]", ruletest.Problems{}},
+		{"Missing", "UndeleteBookRequest", "name", "", ruletest.Problems{{Message: "(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED"}}},
+		{"IrrelevantMessage", "AcquireBookRequest", "name", "", ruletest.Problems{}},
+		{"IrrelevantField", "UndeleteBookRequest", "something_else", "", ruletest.Problems{}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/field_info.proto";
 				message {{.MessageName}} {
 					string {{.FieldName}} = 1{{.FieldBehavior}};
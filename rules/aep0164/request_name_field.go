@@ -15,12 +15,13 @@
 package aep0164
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 )
 
 var requestNameField = &lint.MessageRule{
 	Name:        lint.NewRuleName(164, "request-name-field"),
+	Metadata:    lint.RuleMetadata{Fixable: true},
 	OnlyIf:      isUndeleteRequestMessage,
-	LintMessage: utils.LintFieldPresentAndSingularString("name"),
+	LintMessage: descutil.LintFieldPresentAndSingularString("name"),
 }
@@ -17,7 +17,7 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -26,17 +26,17 @@ func TestRequestNameField(t *testing.T) {
 		name        string
 		MessageName string
 		Field       string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"Valid", "UndeleteBookRequest", `string name = 1;`, nil},
-		{"InvalidMissing", "UndeleteBookRequest", "", testutils.Problems{{Message: "has no"}}},
-		{"InvalidType", "UndeleteBookRequest", "bytes name = 1;", testutils.Problems{{Suggestion: "string"}}},
+		{"InvalidMissing", "UndeleteBookRequest", "", ruletest.Problems{{Message: "has no"}}},
+		{"InvalidType", "UndeleteBookRequest", "bytes name = 1;", ruletest.Problems{{Suggestion: "string"}}},
 		{"IrrelevantMessage", "RemoveBookRequest", "", nil},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				message {{.MessageName}} {
 					{{.Field}}
 					bytes other_field = 2;
@@ -17,19 +17,19 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestRequestNameReference(t *testing.T) {
 	t.Run("Present", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 			message UndeleteBookRequest {
 				string name = 1 [(aep.api.field_info).resource_reference = "library.googleapis.com/Book"];
 			}
 		`)
-		if diff := (testutils.Problems{}).Diff(requestNameReference.Lint(f)); diff != "" {
+		if diff := (ruletest.Problems{}).Diff(requestNameReference.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
 	})
@@ -37,13 +37,13 @@ func TestRequestNameReference(t *testing.T) {
 		for _, test := range []struct {
 			name      string
 			FieldName string
-			problems  testutils.Problems
+			problems  ruletest.Problems
 		}{
-			{"Error", "name", testutils.Problems{{Message: "(aep.api.field_info).resource_reference"}}},
-			{"Irrelevant", "something_else", testutils.Problems{}},
+			{"Error", "name", ruletest.Problems{{Message: "(aep.api.field_info).resource_reference"}}},
+			{"Irrelevant", "something_else", ruletest.Problems{}},
 		} {
 			t.Run(test.name, func(t *testing.T) {
-				f := testutils.ParseProto3Tmpl(t, `
+				f := ruletest.ParseProto3Tmpl(t, `
 					import "aep/api/resource.proto";
   import "aep/api/field_info.proto";
 					message UndeleteBookRequest {
@@ -17,7 +17,7 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc/builder"
 )
 
@@ -28,15 +28,15 @@ func TestRequestUnknownFields(t *testing.T) {
 		messageName string
 		fieldName   string
 		fieldType   *builder.FieldType
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
-		{"Etag", "UndeleteBookRequest", "etag", builder.FieldTypeString(), testutils.Problems{}},
-		{"RequestId", "UndeleteBookRequest", "request_id", builder.FieldTypeString(), testutils.Problems{}},
-		{"ValidateOnly", "UndeleteBookRequest", "validate_only", builder.FieldTypeBool(), testutils.Problems{}},
-		{"Invalid", "UndeleteBookRequest", "application_id", builder.FieldTypeString(), testutils.Problems{{
+		{"Etag", "UndeleteBookRequest", "etag", builder.FieldTypeString(), ruletest.Problems{}},
+		{"RequestId", "UndeleteBookRequest", "request_id", builder.FieldTypeString(), ruletest.Problems{}},
+		{"ValidateOnly", "UndeleteBookRequest", "validate_only", builder.FieldTypeBool(), ruletest.Problems{}},
+		{"Invalid", "UndeleteBookRequest", "application_id", builder.FieldTypeString(), ruletest.Problems{{
 			Message: "Unexpected field",
 		}}},
-		{"Irrelevant", "RemoveBookRequest", "application_id", builder.FieldTypeString(), testutils.Problems{}},
+		{"Irrelevant", "RemoveBookRequest", "application_id", builder.FieldTypeString(), ruletest.Problems{}},
 	}
 
 	// Run each test individually.
@@ -1,8 +1,8 @@
 package aep0164
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -11,7 +11,7 @@ var resourceExpireTimeField = &lint.MessageRule{
 	Name: lint.NewRuleName(164, "resource-expire-time-field"),
 	OnlyIf: func(m *desc.MessageDescriptor) bool {
 		resource := m.GetName()
-		return utils.FindMethod(m.GetFile(), "Undelete"+resource) != nil
+		return descutil.FindMethod(m.GetFile(), "Undelete"+resource) != nil
 	},
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		// for backwards compatibility, do not lint on expire_time.
@@ -3,7 +3,7 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResourceExpireTimeField(t *testing.T) {
@@ -11,15 +11,15 @@ func TestResourceExpireTimeField(t *testing.T) {
 		desc          string
 		MethodName    string
 		ResourceField string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
 		{"Valid", `UndeleteBook`, `google.protobuf.Timestamp purge_time = 1;`, nil},
 		{"Valid", `UndeleteBookLegacyExpireTime`, `google.protobuf.Timestamp expire_time = 1;`, nil},
-		{"Invalid", `UndeleteBook`, ``, testutils.Problems{{Message: "Resources supporting soft delete"}}},
+		{"Invalid", `UndeleteBook`, ``, ruletest.Problems{{Message: "Resources supporting soft delete"}}},
 		{"IrrelevantNoSoftDelete", `GetBook`, ``, nil},
 	} {
 		t.Run(test.desc, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				import "google/protobuf/timestamp.proto";
 				service Library {
 					rpc {{.MethodName}}({{.MethodName}}Request) returns (Book);
@@ -15,16 +15,17 @@
 package aep0164
 
 import (
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 var responseLRO = &lint.MethodRule{
-	Name: lint.NewRuleName(164, "response-lro"),
+	Name:     lint.NewRuleName(164, "response-lro"),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(m *desc.MethodDescriptor) bool {
-		return isUndeleteMethod(m) && utils.IsDeclarativeFriendlyMethod(m)
+		return isUndeleteMethod(m) && descutil.IsDeclarativeFriendlyMethod(m)
 	},
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		if m.GetOutputType().GetFullyQualifiedName() != "google.longrunning.Operation" {
@@ -17,7 +17,7 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResponseLRO(t *testing.T) {
@@ -27,14 +27,14 @@ func TestResponseLRO(t *testing.T) {
 	for _, test := range []struct {
 		name         string
 		ResponseType string
-		problems     testutils.Problems
+		problems     ruletest.Problems
 	}{
 		{"ValidNotDF", "Book", nil},
 		{"ValidLRO", "google.longrunning.Operation", nil},
 		{"NoLongerInvalidDFSync", "Book", nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 				import "aep/api/resource.proto";
 				import "google/longrunning/operations.proto";
 				service Library {
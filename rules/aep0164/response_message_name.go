@@ -18,17 +18,18 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
 // Undelete messages should use google.longrunning.Operation
 // or the resource itself as the response message.
 var responseMessageName = &lint.MethodRule{
-	Name:   lint.NewRuleName(164, "response-message-name"),
-	OnlyIf: isUndeleteMethod,
+	Name:     lint.NewRuleName(164, "response-message-name"),
+	Metadata: lint.RuleMetadata{Fixable: true},
+	OnlyIf:   isUndeleteMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		// Rule check: Establish that for methods such as `UndeleteFoo`, the response
 		// message is `Foo` or `google.longrunning.Operation`.
@@ -36,8 +37,8 @@ var responseMessageName = &lint.MethodRule{
 		got := m.GetOutputType().GetName()
 
 		// If the return type is an LRO, use the annotated response type instead.
-		if utils.IsOperation(m.GetOutputType()) {
-			got = utils.GetOperationInfo(m).GetResponseType()
+		if descutil.IsOperation(m.GetOutputType()) {
+			got = descutil.GetOperationInfo(m).GetResponseType()
 		}
 
 		// Return a problem if we did not get the expected return name.
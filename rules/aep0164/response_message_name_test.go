@@ -17,7 +17,7 @@ package aep0164
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestResponseMessageName(t *testing.T) {
@@ -53,21 +53,21 @@ func TestResponseMessageName(t *testing.T) {
 		tmpl         string
 		MethodName   string
 		RespTypeName string
-		problems     testutils.Problems
+		problems     ruletest.Problems
 	}{
-		{"ValidResource", tmpl["sync"], "UndeleteBook", "Book", testutils.Problems{}},
-		{"ValidLROResource", tmpl["lro"], "UndeleteBook", "Book", testutils.Problems{}},
-		{"Invalid", tmpl["sync"], "UndeleteBook", "UndeleteBookResponse", testutils.Problems{{Suggestion: "Book"}}},
-		{"InvalidLRO", tmpl["lro"], "UndeleteBook", "UndeleteBookResponse", testutils.Problems{{Suggestion: "Book"}}},
-		{"Irrelevant", tmpl["sync"], "DestroyBook", "DestroyBookResponse", testutils.Problems{}},
-		{"IrrelevantLRO", tmpl["lro"], "DestroyBook", "DestroyBookResponse", testutils.Problems{}},
+		{"ValidResource", tmpl["sync"], "UndeleteBook", "Book", ruletest.Problems{}},
+		{"ValidLROResource", tmpl["lro"], "UndeleteBook", "Book", ruletest.Problems{}},
+		{"Invalid", tmpl["sync"], "UndeleteBook", "UndeleteBookResponse", ruletest.Problems{{Suggestion: "Book"}}},
+		{"InvalidLRO", tmpl["lro"], "UndeleteBook", "UndeleteBookResponse", ruletest.Problems{{Suggestion: "Book"}}},
+		{"Irrelevant", tmpl["sync"], "DestroyBook", "DestroyBookResponse", ruletest.Problems{}},
+		{"IrrelevantLRO", tmpl["lro"], "DestroyBook", "DestroyBookResponse", ruletest.Problems{}},
 	}
 
 	// Run each test individually.
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
 			// Create a minimal service with a AEP-164 Undelete method
-			file := testutils.ParseProto3Tmpl(t, test.tmpl, test)
+			file := ruletest.ParseProto3Tmpl(t, test.tmpl, test)
 
 			// Run the lint rule, and establish that it returns the expected problems.
 			method := file.GetServices()[0].GetMethods()[0]
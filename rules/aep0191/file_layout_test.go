@@ -17,13 +17,13 @@ package aep0191
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc/builder"
 )
 
 func TestFileLayout(t *testing.T) {
 	t.Run("Valid", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			service Library {}
 			message Book {}
 			enum Format {
@@ -36,24 +36,24 @@ func TestFileLayout(t *testing.T) {
 	})
 
 	t.Run("InvalidServiceAfterMessage", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			message Book {}
 			service Library {}
 		`)
-		want := testutils.Problems{{Descriptor: f.GetServices()[0]}}
+		want := ruletest.Problems{{Descriptor: f.GetServices()[0]}}
 		if diff := want.Diff(fileLayout.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
 	})
 
 	t.Run("InvalidEnumBeforeMessage", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			enum Format {
 				FORMAT_UNSPECIFIED = 0;
 			}
 			message Book {}
 		`)
-		want := testutils.Problems{{Descriptor: f.GetEnumTypes()[0]}}
+		want := ruletest.Problems{{Descriptor: f.GetEnumTypes()[0]}}
 		if diff := want.Diff(fileLayout.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
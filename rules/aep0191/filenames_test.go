@@ -17,7 +17,7 @@ package aep0191
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc/builder"
 )
 
@@ -25,24 +25,24 @@ func TestFilename(t *testing.T) {
 	tests := []struct {
 		testName string
 		filename string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "library.proto", testutils.Problems{}},
-		{"ValidDirectory", "google/library.proto", testutils.Problems{}},
-		{"ValidFileNameWithSnakeCase", "library_test.proto", testutils.Problems{}},
-		{"InvalidFileNameNotSnakeCase", "library.test.proto", testutils.Problems{{Message: "invalid characters"}}},
-		{"InvalidCharacterUpperCase", "library_Test.proto", testutils.Problems{{Message: "invalid characters"}}},
-		{"InvalidCharacterDollar", "library_$test.proto", testutils.Problems{{Message: "invalid characters"}}},
-		{"InvalidCharacterSpace", "library test.proto", testutils.Problems{{Message: "invalid characters"}}},
-		{"InvalidCharacterHash", "library_#test.proto", testutils.Problems{{Message: "invalid characters"}}},
-		{"InvalidStable", "v1.proto", testutils.Problems{{Message: "proto version"}}},
-		{"InvalidBigStable", "v20.proto", testutils.Problems{{Message: "proto version"}}},
-		{"InvalidStableDirectory", "google/library/v1.proto", testutils.Problems{{Message: "proto version"}}},
-		{"InvalidAlphaUnnumbered", "v1alpha.proto", testutils.Problems{{Message: "proto version"}}},
-		{"InvalidAlphaNumbered", "v1alpha1.proto", testutils.Problems{{Message: "proto version"}}},
-		{"InvalidBetaUnnumbered", "v1beta.proto", testutils.Problems{{Message: "proto version"}}},
-		{"InvalidBetaNumbered", "v1beta1.proto", testutils.Problems{{Message: "proto version"}}},
-		{"InvalidPoint", "v1p1beta1.proto", testutils.Problems{{Message: "proto version"}}},
+		{"Valid", "library.proto", ruletest.Problems{}},
+		{"ValidDirectory", "google/library.proto", ruletest.Problems{}},
+		{"ValidFileNameWithSnakeCase", "library_test.proto", ruletest.Problems{}},
+		{"InvalidFileNameNotSnakeCase", "library.test.proto", ruletest.Problems{{Message: "invalid characters"}}},
+		{"InvalidCharacterUpperCase", "library_Test.proto", ruletest.Problems{{Message: "invalid characters"}}},
+		{"InvalidCharacterDollar", "library_$test.proto", ruletest.Problems{{Message: "invalid characters"}}},
+		{"InvalidCharacterSpace", "library test.proto", ruletest.Problems{{Message: "invalid characters"}}},
+		{"InvalidCharacterHash", "library_#test.proto", ruletest.Problems{{Message: "invalid characters"}}},
+		{"InvalidStable", "v1.proto", ruletest.Problems{{Message: "proto version"}}},
+		{"InvalidBigStable", "v20.proto", ruletest.Problems{{Message: "proto version"}}},
+		{"InvalidStableDirectory", "google/library/v1.proto", ruletest.Problems{{Message: "proto version"}}},
+		{"InvalidAlphaUnnumbered", "v1alpha.proto", ruletest.Problems{{Message: "proto version"}}},
+		{"InvalidAlphaNumbered", "v1alpha1.proto", ruletest.Problems{{Message: "proto version"}}},
+		{"InvalidBetaUnnumbered", "v1beta.proto", ruletest.Problems{{Message: "proto version"}}},
+		{"InvalidBetaNumbered", "v1beta1.proto", ruletest.Problems{{Message: "proto version"}}},
+		{"InvalidPoint", "v1p1beta1.proto", ruletest.Problems{{Message: "proto version"}}},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
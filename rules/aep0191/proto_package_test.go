@@ -17,7 +17,7 @@ package aep0191
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc/builder"
 )
 
@@ -26,12 +26,12 @@ func TestProtoPkg(t *testing.T) {
 		testName string
 		filename string
 		pkg      string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "google/example/library/v1/library.proto", "google.example.library.v1", testutils.Problems{}},
-		{"InvalidPackage", "google/example/library/v1/library.proto", "google.library.v1", testutils.Problems{{Message: "directory structure"}}},
-		{"InvalidDirectory", "google/v1/library.proto", "google.example.library.v1", testutils.Problems{{Message: "directory structure"}}},
-		{"IgnoreRootDirectory", "library.proto", "google.example.library.v1", testutils.Problems{}},
+		{"Valid", "google/example/library/v1/library.proto", "google.example.library.v1", ruletest.Problems{}},
+		{"InvalidPackage", "google/example/library/v1/library.proto", "google.library.v1", ruletest.Problems{{Message: "directory structure"}}},
+		{"InvalidDirectory", "google/v1/library.proto", "google.example.library.v1", ruletest.Problems{{Message: "directory structure"}}},
+		{"IgnoreRootDirectory", "library.proto", "google.example.library.v1", ruletest.Problems{}},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
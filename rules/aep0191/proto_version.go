@@ -25,6 +25,7 @@ import (
 var syntax = &lint.FileRule{
 	Name:     lint.NewRuleName(191, "proto-version"),
 	RuleType: lint.NewRuleType(lint.MustRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	LintFile: func(f *desc.FileDescriptor) []lint.Problem {
 		if !f.IsProto3() && f.Edition() < descriptorpb.Edition_EDITION_PROTO3 {
 			return []lint.Problem{{
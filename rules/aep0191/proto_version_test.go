@@ -17,7 +17,7 @@ package aep0191
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 	"github.com/jhump/protoreflect/desc/builder"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
@@ -27,12 +27,12 @@ func TestSyntax(t *testing.T) {
 	tests := []struct {
 		testName string
 		edition  descriptorpb.Edition
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid (proto3)", descriptorpb.Edition_EDITION_PROTO3, testutils.Problems{}},
-		{"Valid (2023)", descriptorpb.Edition_EDITION_2023, testutils.Problems{}},
-		{"Valid (2024)", descriptorpb.Edition_EDITION_2024, testutils.Problems{}},
-		{"Invalid (proto2)", descriptorpb.Edition_EDITION_PROTO2, testutils.Problems{{Suggestion: `edition = "2023";`}}},
+		{"Valid (proto3)", descriptorpb.Edition_EDITION_PROTO3, ruletest.Problems{}},
+		{"Valid (2023)", descriptorpb.Edition_EDITION_2023, ruletest.Problems{}},
+		{"Valid (2024)", descriptorpb.Edition_EDITION_2024, ruletest.Problems{}},
+		{"Invalid (proto2)", descriptorpb.Edition_EDITION_PROTO2, ruletest.Problems{{Suggestion: `edition = "2023";`}}},
 	}
 
 	// Run each permutation as an individual test.
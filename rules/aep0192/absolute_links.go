@@ -18,9 +18,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -30,7 +30,7 @@ var absoluteLinks = &lint.DescriptorRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintDescriptor: func(d desc.Descriptor) []lint.Problem {
 		comment := strings.Join(
-			utils.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments()).External,
+			descutil.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments()).External,
 			"\n",
 		)
 
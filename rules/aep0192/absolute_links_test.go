@@ -17,21 +17,21 @@ package aep0192
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestAbsoluteLinks(t *testing.T) {
 	for _, test := range []struct {
 		name     string
 		URI      string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Valid", "https://google.com/", testutils.Problems{}},
-		{"NoProtocol", "google.com", testutils.Problems{{Message: "https://"}}},
-		{"Relative", "/foo/bar/baz.html", testutils.Problems{{Message: "https://"}}},
+		{"Valid", "https://google.com/", ruletest.Problems{}},
+		{"NoProtocol", "google.com", ruletest.Problems{{Message: "https://"}}},
+		{"Relative", "/foo/bar/baz.html", ruletest.Problems{{Message: "https://"}}},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			  // This is [a link]({{.URI}}).
 				message Foo {}
 			`, test)
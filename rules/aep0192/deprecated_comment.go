@@ -17,8 +17,8 @@ package aep0192
 import (
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,7 +27,7 @@ var deprecatedComment = &lint.DescriptorRule{
 	RuleType: lint.NewRuleType(lint.MustRule),
 	OnlyIf:   isDeprecated,
 	LintDescriptor: func(d desc.Descriptor) []lint.Problem {
-		comment := utils.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments())
+		comment := descutil.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments())
 		if len(comment.External) > 0 && strings.HasPrefix(comment.External[0], "Deprecated:") {
 			return nil
 		}
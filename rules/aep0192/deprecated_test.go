@@ -17,12 +17,12 @@ package aep0192
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 // These are split up since templating doesn't play nicely with inserting protobuf options.
 func TestValidDescriptor(t *testing.T) {
-	file := testutils.ParseProto3String(t, `
+	file := ruletest.ParseProto3String(t, `
     // A library service.
     service Library {
       // Retrieves a book.
@@ -32,12 +32,12 @@ func TestValidDescriptor(t *testing.T) {
     message Book {}
   `)
 
-	serviceProblems := testutils.Problems{}
+	serviceProblems := ruletest.Problems{}
 	if diff := serviceProblems.Diff(deprecatedComment.Lint(file)); diff != "" {
 		t.Error(diff)
 	}
 
-	methodProblems := testutils.Problems{}
+	methodProblems := ruletest.Problems{}
 	if diff := methodProblems.Diff(deprecatedComment.Lint(file)); diff != "" {
 		t.Error(diff)
 	}
@@ -47,15 +47,15 @@ func TestDeprecatedMethod(t *testing.T) {
 	tests := []struct {
 		testName      string
 		MethodComment string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
 		{"ValidMethodDeprecated", "// Deprecated: Don't use this.\n// Method comment.", nil},
-		{"InvalidMethodDeprecated", "// Method comment.", testutils.Problems{{Message: `Use "Deprecated: <reason>"`}}},
+		{"InvalidMethodDeprecated", "// Method comment.", ruletest.Problems{{Message: `Use "Deprecated: <reason>"`}}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
         service Library {
 
           {{.MethodComment}}
@@ -79,15 +79,15 @@ func TestDeprecatedService(t *testing.T) {
 	tests := []struct {
 		testName       string
 		ServiceComment string
-		problems       testutils.Problems
+		problems       ruletest.Problems
 	}{
 		{"ValidServiceDeprecated", "// Deprecated: Don't use this.\n// Service comment.", nil},
-		{"InvalidServiceDeprecated", "// Service comment.", testutils.Problems{{Message: `Use "Deprecated: <reason>"`}}},
+		{"InvalidServiceDeprecated", "// Service comment.", ruletest.Problems{{Message: `Use "Deprecated: <reason>"`}}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
         {{.ServiceComment}}
         service Library {
           option deprecated = true;
@@ -109,15 +109,15 @@ func TestDeprecatedField(t *testing.T) {
 	tests := []struct {
 		testName     string
 		FieldComment string
-		problems     testutils.Problems
+		problems     ruletest.Problems
 	}{
 		{"ValidFieldDeprecated", "// Deprecated: Don't use this.\n// Field comment.", nil},
-		{"InvalidFieldDeprecated", "// Field comment.", testutils.Problems{{Message: `Use "Deprecated: <reason>"`}}},
+		{"InvalidFieldDeprecated", "// Field comment.", ruletest.Problems{{Message: `Use "Deprecated: <reason>"`}}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
         message GetBookRequest {
 			{{.FieldComment}}
 			string name = 1 [deprecated = true];
@@ -136,15 +136,15 @@ func TestDeprecatedEnum(t *testing.T) {
 	tests := []struct {
 		testName    string
 		EnumComment string
-		problems    testutils.Problems
+		problems    ruletest.Problems
 	}{
 		{"ValidEnumDeprecated", "// Deprecated: Don't use this.\n// Enum comment.", nil},
-		{"InvalidEnumDeprecated", "// Enum comment.", testutils.Problems{{Message: `Use "Deprecated: <reason>"`}}},
+		{"InvalidEnumDeprecated", "// Enum comment.", ruletest.Problems{{Message: `Use "Deprecated: <reason>"`}}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 		{{.EnumComment}}
 		enum State {
 			option deprecated = true;
@@ -165,15 +165,15 @@ func TestDeprecatedEnumValue(t *testing.T) {
 	tests := []struct {
 		testName         string
 		EnumValueComment string
-		problems         testutils.Problems
+		problems         ruletest.Problems
 	}{
 		{"ValidEnumValueDeprecated", "// Deprecated: Don't use this.\n// EnumValue comment.", nil},
-		{"InvalidEnumValueDeprecated", "// EnumValue comment.", testutils.Problems{{Message: `Use "Deprecated: <reason>"`}}},
+		{"InvalidEnumValueDeprecated", "// EnumValue comment.", ruletest.Problems{{Message: `Use "Deprecated: <reason>"`}}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 		enum State {
 			{{.EnumValueComment}}
 			STATE_UNSPECIFIED = 0 [deprecated = true];
@@ -192,15 +192,15 @@ func TestDeprecatedMessage(t *testing.T) {
 	tests := []struct {
 		testName       string
 		MessageComment string
-		problems       testutils.Problems
+		problems       ruletest.Problems
 	}{
 		{"ValidMessageDeprecated", "// Deprecated: Don't use this.\n// Message comment.", nil},
-		{"InvalidMessageDeprecated", "// Message comment.", testutils.Problems{{Message: `Use "Deprecated: <reason>"`}}},
+		{"InvalidMessageDeprecated", "// Message comment.", ruletest.Problems{{Message: `Use "Deprecated: <reason>"`}}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 		{{.MessageComment}}
         message GetBookRequest {
 			option deprecated = true;
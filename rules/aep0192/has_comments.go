@@ -17,9 +17,9 @@ package aep0192
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/locations"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -28,7 +28,7 @@ var hasComments = &lint.DescriptorRule{
 	Name:     lint.NewRuleName(192, "has-comments"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintDescriptor: func(d desc.Descriptor) (problems []lint.Problem) {
-		comment := utils.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments())
+		comment := descutil.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments())
 		if len(comment.External) == 0 {
 			problems = append(problems, lint.Problem{
 				Message:    fmt.Sprintf("Missing comment over %q.", d.GetName()),
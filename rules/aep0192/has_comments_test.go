@@ -17,20 +17,20 @@ package aep0192
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestFieldHasComments(t *testing.T) {
 	for _, tst := range []struct {
 		testName string
 		Comment  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "This is the title.", nil},
-		{"Invalid", "", testutils.Problems{{Message: `Missing comment over`}}},
-		{"InvalidInternal", "(-- Internal only comment --)", testutils.Problems{{Message: `Missing comment over`}}},
+		{"Invalid", "", ruletest.Problems{{Message: `Missing comment over`}}},
+		{"InvalidInternal", "(-- Internal only comment --)", ruletest.Problems{{Message: `Missing comment over`}}},
 	} {
-		file := testutils.ParseProto3Tmpl(t, `
+		file := ruletest.ParseProto3Tmpl(t, `
 		// This is a book.
 		message Book {
 			// The resource name.
@@ -50,13 +50,13 @@ func TestMessageHasComments(t *testing.T) {
 	for _, tst := range []struct {
 		testName string
 		Comment  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "This is a book.", nil},
-		{"Invalid", "", testutils.Problems{{Message: `Missing comment over`}}},
-		{"InvalidInternal", "(-- Internal only comment --)", testutils.Problems{{Message: `Missing comment over`}}},
+		{"Invalid", "", ruletest.Problems{{Message: `Missing comment over`}}},
+		{"InvalidInternal", "(-- Internal only comment --)", ruletest.Problems{{Message: `Missing comment over`}}},
 	} {
-		file := testutils.ParseProto3Tmpl(t, `
+		file := ruletest.ParseProto3Tmpl(t, `
 		// {{ .Comment }}
 		message Book {
 			// The resource name.
@@ -17,8 +17,8 @@ package aep0192
 import (
 	"regexp"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -29,7 +29,7 @@ var noHTML = &lint.DescriptorRule{
 		return d.GetSourceInfo() != nil
 	},
 	LintDescriptor: func(d desc.Descriptor) []lint.Problem {
-		for _, comment := range utils.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments()).External {
+		for _, comment := range descutil.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments()).External {
 			if htmlTag.MatchString(comment) {
 				return []lint.Problem{{
 					Message:    "Comments must not include raw HTML.",
@@ -18,17 +18,17 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestNoHTML(t *testing.T) {
-	rawHTMLProblem := testutils.Problems{{Message: "must not include raw HTML"}}
-	noProblems := testutils.Problems{}
+	rawHTMLProblem := ruletest.Problems{{Message: "must not include raw HTML"}}
+	noProblems := ruletest.Problems{}
 
 	for _, test := range []struct {
 		name     string
 		comment  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "It is **great!**", noProblems},
 		{"ValidMath", "x < 10", noProblems},
@@ -41,7 +41,7 @@ func TestNoHTML(t *testing.T) {
 		{"IntentionalFalseNegativeInnerSpace", "Something < b > bold < /b >", noProblems},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3String(t, strings.ReplaceAll(`
+			f := ruletest.ParseProto3String(t, strings.ReplaceAll(`
 				// A foo. {{.Comment}}
 				message Foo {}
 			`, "{{.Comment}}", test.comment))
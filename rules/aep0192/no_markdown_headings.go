@@ -18,8 +18,8 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -27,7 +27,7 @@ var noMarkdownHeadings = &lint.DescriptorRule{
 	Name:     lint.NewRuleName(192, "no-markdown-headings"),
 	RuleType: lint.NewRuleType(lint.MustRule),
 	LintDescriptor: func(d desc.Descriptor) []lint.Problem {
-		for _, cmt := range utils.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments()).External {
+		for _, cmt := range descutil.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments()).External {
 			for _, line := range strings.Split(cmt, "\n") {
 				if heading.FindString(line) != "" {
 					return []lint.Problem{{
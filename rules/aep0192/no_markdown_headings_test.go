@@ -18,15 +18,15 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestNoMarkdownHeadings(t *testing.T) {
-	problem := testutils.Problems{{Message: "Markdown headings"}}
+	problem := ruletest.Problems{{Message: "Markdown headings"}}
 	for _, test := range []struct {
 		name     string
 		comment  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "foo bar baz", nil},
 		{"ValidMidline", "foo bar # baz", nil},
@@ -38,7 +38,7 @@ func TestNoMarkdownHeadings(t *testing.T) {
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			cmt := "// " + strings.ReplaceAll(test.comment, "\n", "\n// ")
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			  {{.Comment}}
 			  message Foo {}
 			`, struct{ Comment string }{cmt})
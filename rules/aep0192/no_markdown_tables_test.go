@@ -17,15 +17,15 @@ package aep0192
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestNoMarkdownTables(t *testing.T) {
-	problem := testutils.Problems{{Message: "Markdown tables"}}
+	problem := ruletest.Problems{{Message: "Markdown tables"}}
 	for _, test := range []struct {
 		name     string
 		Comment  string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
 		{"Valid", "foo bar baz", nil},
 		{"ValidMidline", "foo - bar", nil},
@@ -37,7 +37,7 @@ func TestNoMarkdownTables(t *testing.T) {
 		{"BiggerColumnTable", "------------ | -----", problem},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			  // {{.Comment}}
 			  message Foo {}
 			`, test)
@@ -17,8 +17,8 @@ package aep0192
 import (
 	"fmt"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
@@ -30,7 +30,7 @@ var onlyLeadingComments = &lint.DescriptorRule{
 	LintDescriptor: func(d desc.Descriptor) []lint.Problem {
 		problems := []lint.Problem{}
 		c := d.GetSourceInfo()
-		if len(utils.SeparateInternalComments(c.GetTrailingComments()).External) > 0 {
+		if len(descutil.SeparateInternalComments(c.GetTrailingComments()).External) > 0 {
 			problems = append(problems, lint.Problem{
 				Message: fmt.Sprintf(
 					"%q should have only leading (not trailing) public comments.",
@@ -39,7 +39,7 @@ var onlyLeadingComments = &lint.DescriptorRule{
 				Descriptor: d,
 			})
 		}
-		if len(utils.SeparateInternalComments(c.GetLeadingDetachedComments()...).External) > 0 {
+		if len(descutil.SeparateInternalComments(c.GetLeadingDetachedComments()...).External) > 0 {
 			problems = append(problems, lint.Problem{
 				Message: fmt.Sprintf(
 					"%q has comments with empty lines between the comment and %q.",
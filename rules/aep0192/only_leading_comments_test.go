@@ -17,7 +17,7 @@ package aep0192
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestOnlyLeadingComments(t *testing.T) {
@@ -25,54 +25,54 @@ func TestOnlyLeadingComments(t *testing.T) {
 		testName string
 		Detached string
 		Trailing string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"ValidNone", "", "", testutils.Problems{}},
-		{"ValidInternalDetached", "// (-- detached --)", "", testutils.Problems{}},
-		{"ValidInternalTrailing", "", "// (-- trailing --)", testutils.Problems{}},
-		{"ValidInternalTrailingNoClose", "", "// (-- trailing", testutils.Problems{}},
+		{"ValidNone", "", "", ruletest.Problems{}},
+		{"ValidInternalDetached", "// (-- detached --)", "", ruletest.Problems{}},
+		{"ValidInternalTrailing", "", "// (-- trailing --)", ruletest.Problems{}},
+		{"ValidInternalTrailingNoClose", "", "// (-- trailing", ruletest.Problems{}},
 		{
 			"ValidInternalBoth",
 			"// (-- detached --)",
 			"// (-- trailing --)",
-			testutils.Problems{},
+			ruletest.Problems{},
 		},
 		{
 			"ValidInternalBothDouble",
 			"// (-- detached --)\n// (-- detached --)",
 			"// (-- trailing --)\n// (-- trailing --)",
-			testutils.Problems{},
+			ruletest.Problems{},
 		},
-		{"InvalidDetached", "// detached", "", testutils.Problems{{Message: "empty lines"}}},
-		{"InvalidTrailing", "", "// trailing", testutils.Problems{{Message: "trailing"}}},
+		{"InvalidDetached", "// detached", "", ruletest.Problems{{Message: "empty lines"}}},
+		{"InvalidTrailing", "", "// trailing", ruletest.Problems{{Message: "trailing"}}},
 		{
 			"InvalidBoth",
 			"// detached",
 			"// trailing",
-			testutils.Problems{{Message: "trailing"}, {Message: "empty lines"}},
+			ruletest.Problems{{Message: "trailing"}, {Message: "empty lines"}},
 		},
 		{
 			"InvalidPartialDetachedInternalFirst",
 			"// (-- detached --) detached",
 			"",
-			testutils.Problems{{Message: "empty lines"}},
+			ruletest.Problems{{Message: "empty lines"}},
 		},
 		{
 			"InvalidPartialDetachedExternalFirst",
 			"// detached (-- detached --)",
 			"",
-			testutils.Problems{{Message: "empty lines"}},
+			ruletest.Problems{{Message: "empty lines"}},
 		},
 		{
 			"InvalidPartialDetachedSandwich",
 			"// (-- detached --) detached (-- detached --)",
 			"",
-			testutils.Problems{{Message: "empty lines"}},
+			ruletest.Problems{{Message: "empty lines"}},
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				{{.Detached}}
 
 				// The book.
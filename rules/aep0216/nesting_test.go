@@ -17,19 +17,19 @@ package aep0216
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestNesting(t *testing.T) {
 	t.Run("Nested", func(t *testing.T) {
-		f := testutils.ParseProto3String(t, `
+		f := ruletest.ParseProto3String(t, `
 			message Foo {
 				enum State {
 					STATE_UNSPECIFIED = 0;
 				}
 			}
 		`)
-		if diff := (testutils.Problems{}).Diff(nesting.Lint(f)); diff != "" {
+		if diff := (ruletest.Problems{}).Diff(nesting.Lint(f)); diff != "" {
 			t.Error(diff)
 		}
 	})
@@ -39,15 +39,15 @@ func TestNesting(t *testing.T) {
 			MessageName string
 			EnumName    string
 			PackageStmt string
-			problems    testutils.Problems
+			problems    ruletest.Problems
 		}{
-			{"ShouldNest", "Foo", "FooState", "package test;", testutils.Problems{{Message: "Nest"}}},
-			{"ShouldNestNoPkg", "Foo", "FooState", "", testutils.Problems{{Message: "Nest"}}},
-			{"ValidNoFoo", "Bar", "FooState", "package test;", testutils.Problems{}},
+			{"ShouldNest", "Foo", "FooState", "package test;", ruletest.Problems{{Message: "Nest"}}},
+			{"ShouldNestNoPkg", "Foo", "FooState", "", ruletest.Problems{{Message: "Nest"}}},
+			{"ValidNoFoo", "Bar", "FooState", "package test;", ruletest.Problems{}},
 		}
 		for _, test := range tests {
 			t.Run(test.name, func(t *testing.T) {
-				f := testutils.ParseProto3Tmpl(t, `
+				f := ruletest.ParseProto3Tmpl(t, `
 					{{.PackageStmt}}
 					message {{.MessageName}} {}
 					enum {{.EnumName}} {
@@ -17,8 +17,8 @@ package aep0216
 import (
 	"strings"
 
+	"github.com/aep-dev/api-linter/aep/descutil"
 	"github.com/aep-dev/api-linter/lint"
-	"github.com/aep-dev/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 	"google.golang.org/genproto/googleapis/api/annotations"
 )
@@ -41,7 +41,7 @@ var stateFieldOutputOnly = &lint.FieldRule{
 		return true
 	},
 	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
-		behaviors := utils.GetFieldBehavior(f)
+		behaviors := descutil.GetFieldBehavior(f)
 		if !behaviors.Contains(annotations.FieldBehavior_OUTPUT_ONLY.String()) {
 			return []lint.Problem{
 				{
@@ -17,7 +17,7 @@ package aep0216
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestStateFieldOutputOnly(t *testing.T) {
@@ -26,25 +26,25 @@ func TestStateFieldOutputOnly(t *testing.T) {
 		FieldName     string
 		FieldType     string
 		FieldBehavior string
-		problems      testutils.Problems
+		problems      ruletest.Problems
 	}{
 		// Accepted
-		{"ValidState", "state", "State", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", testutils.Problems{}},
-		{"ValidOtherFieldName", "country", "State", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", testutils.Problems{}},
-		{"ValidStateSuffix", "state", "WritersBlockState", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", testutils.Problems{}},
+		{"ValidState", "state", "State", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", ruletest.Problems{}},
+		{"ValidOtherFieldName", "country", "State", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", ruletest.Problems{}},
+		{"ValidStateSuffix", "state", "WritersBlockState", "[(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_OUTPUT_ONLY]", ruletest.Problems{}},
 
 		// No Annotation
-		{"InvalidState", "state", "State", "", testutils.Problems{{Message: "OUTPUT_ONLY"}}},
-		{"InvalidWithSuffix", "state", "WritersBlockState", "", testutils.Problems{{Message: "OUTPUT_ONLY"}}},
-		{"InvalidWithFieldName", "city", "State", "", testutils.Problems{{Message: "OUTPUT_ONLY"}}},
+		{"InvalidState", "state", "State", "", ruletest.Problems{{Message: "OUTPUT_ONLY"}}},
+		{"InvalidWithSuffix", "state", "WritersBlockState", "", ruletest.Problems{{Message: "OUTPUT_ONLY"}}},
+		{"InvalidWithFieldName", "city", "State", "", ruletest.Problems{{Message: "OUTPUT_ONLY"}}},
 
 		// Ignored
-		{"NotAStateField", "state", "StateOfDespair", "", testutils.Problems{}},
-		{"NotAnEnum", "state", "StateOfState", "", testutils.Problems{}},
+		{"NotAStateField", "state", "StateOfDespair", "", ruletest.Problems{}},
+		{"NotAnEnum", "state", "StateOfState", "", ruletest.Problems{}},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			import "aep/api/field_info.proto";
 
 			message Book {
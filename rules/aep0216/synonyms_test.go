@@ -17,22 +17,22 @@ package aep0216
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestSynonyms(t *testing.T) {
 	tests := []struct {
 		Name     string
-		problems testutils.Problems
+		problems ruletest.Problems
 	}{
-		{"Status", testutils.Problems{{Suggestion: "State"}}},
-		{"FooStatus", testutils.Problems{{Suggestion: "FooState"}}},
-		{"State", testutils.Problems{}},
-		{"Foo", testutils.Problems{}},
+		{"Status", ruletest.Problems{{Suggestion: "State"}}},
+		{"FooStatus", ruletest.Problems{{Suggestion: "FooState"}}},
+		{"State", ruletest.Problems{}},
+		{"Foo", ruletest.Problems{}},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			f := testutils.ParseProto3Tmpl(t, `
+			f := ruletest.ParseProto3Tmpl(t, `
 			enum {{.Name}} {
 				UNSPECIFIED = 0;
 			}
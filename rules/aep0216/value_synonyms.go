@@ -26,6 +26,7 @@ import (
 var valueSynonyms = &lint.EnumValueRule{
 	Name:     lint.NewRuleName(216, "value-synonyms"),
 	RuleType: lint.NewRuleType(lint.ShouldRule),
+	Metadata: lint.RuleMetadata{Fixable: true},
 	OnlyIf: func(v *desc.EnumValueDescriptor) bool {
 		return strings.HasSuffix(v.GetEnum().GetName(), "State")
 	},
@@ -17,7 +17,7 @@ package aep0216
 import (
 	"testing"
 
-	"github.com/aep-dev/api-linter/rules/internal/testutils"
+	"github.com/aep-dev/api-linter/aep/ruletest"
 )
 
 func TestValueSynonyms(t *testing.T) {
@@ -25,20 +25,20 @@ func TestValueSynonyms(t *testing.T) {
 		name      string
 		EnumName  string
 		ValueName string
-		problems  testutils.Problems
+		problems  ruletest.Problems
 	}{
 		{"ValidSucceeded", "State", "SUCCEEDED", nil},
 		{"ValidDeleted", "State", "DELETED", nil},
-		{"InvalidSuccess", "State", "SUCCESS", testutils.Problems{{Suggestion: "SUCCEEDED"}}},
-		{"InvalidSuccessful", "State", "SUCCESSFUL", testutils.Problems{{Suggestion: "SUCCEEDED"}}},
-		{"InvalidCanceled", "State", "CANCELED", testutils.Problems{{Suggestion: "CANCELLED"}}},
-		{"InvalidCanceling", "State", "CANCELING", testutils.Problems{{Suggestion: "CANCELLING"}}},
-		{"InvalidFail", "State", "FAIL", testutils.Problems{{Suggestion: "FAILED"}}},
-		{"InvalidFailure", "State", "FAILURE", testutils.Problems{{Suggestion: "FAILED"}}},
+		{"InvalidSuccess", "State", "SUCCESS", ruletest.Problems{{Suggestion: "SUCCEEDED"}}},
+		{"InvalidSuccessful", "State", "SUCCESSFUL", ruletest.Problems{{Suggestion: "SUCCEEDED"}}},
+		{"InvalidCanceled", "State", "CANCELED", ruletest.Problems{{Suggestion: "CANCELLED"}}},
+		{"InvalidCanceling", "State", "CANCELING", ruletest.Problems{{Suggestion: "CANCELLING"}}},
+		{"InvalidFail", "State", "FAIL", ruletest.Problems{{Suggestion: "FAILED"}}},
+		{"InvalidFailure", "State", "FAILURE", ruletest.Problems{{Suggestion: "FAILED"}}},
 		{"Irrelevant", "Foo", "SUCCESSFUL", nil},
 	} {
 		t.Run(test.name, func(t *testing.T) {
-			file := testutils.ParseProto3Tmpl(t, `
+			file := ruletest.ParseProto3Tmpl(t, `
 				enum {{.EnumName}} {
 					{{.EnumName}}_UNSPECIFIED = 0;
 					{{.ValueName}} = 1;
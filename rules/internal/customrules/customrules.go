@@ -0,0 +1,239 @@
+// Package customrules compiles the custom rules declared in configs, written
+// as CEL expressions, into rules.
+package customrules
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/aep-dev/api-linter/aep/descutil"
+	"github.com/aep-dev/api-linter/lint"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// variables are the variables of the view of an element available to the
+// expressions, along with the CEL string extensions. Variables which do not
+// apply to the kind of the element are empty.
+var variables = []cel.EnvOption{
+	ext.Strings(),
+
+	// Every element.
+	cel.Variable("kind", cel.StringType),
+	cel.Variable("name", cel.StringType),
+	cel.Variable("full_name", cel.StringType),
+	cel.Variable("parent", cel.StringType),
+	cel.Variable("file", cel.StringType),
+	cel.Variable("package_name", cel.StringType),
+	cel.Variable("comments", cel.StringType),
+	cel.Variable("options", cel.MapType(cel.StringType, cel.DynType)),
+	// Files.
+	cel.Variable("services", cel.ListType(cel.StringType)),
+	cel.Variable("messages", cel.ListType(cel.StringType)),
+	cel.Variable("enums", cel.ListType(cel.StringType)),
+	// Services.
+	cel.Variable("methods", cel.ListType(cel.StringType)),
+	// Methods.
+	cel.Variable("input_type", cel.StringType),
+	cel.Variable("output_type", cel.StringType),
+	cel.Variable("client_streaming", cel.BoolType),
+	cel.Variable("server_streaming", cel.BoolType),
+	// Messages.
+	cel.Variable("fields", cel.ListType(cel.StringType)),
+	cel.Variable("resource", cel.DynType),
+	// Fields.
+	cel.Variable("type_name", cel.StringType),
+	cel.Variable("number", cel.IntType),
+	cel.Variable("repeated", cel.BoolType),
+	cel.Variable("field_behavior", cel.ListType(cel.StringType)),
+	// Enums.
+	cel.Variable("values", cel.ListType(cel.StringType)),
+}
+
+var env = mustNewEnv()
+
+func mustNewEnv() *cel.Env {
+	e, err := cel.NewEnv(variables...)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// New compiles a custom rule.
+func New(r lint.CustomRule) (lint.ProtoRule, error) {
+	ast, iss := env.Compile(r.ReportIf)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("custom rule %q: report_if: %w", r.Name, iss.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("custom rule %q: report_if must be a bool expression, not %s", r.Name, ast.OutputType())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("custom rule %q: report_if: %w", r.Name, err)
+	}
+	msg, err := template.New(r.Name).Option("missingkey=error").Parse(r.Message)
+	if err != nil {
+		return nil, fmt.Errorf("custom rule %q: message: %w", r.Name, err)
+	}
+
+	c := &checker{prg: prg, msg: msg}
+	name := lint.RuleName(r.Name)
+	rt, err := lint.ParseRuleType(r.Severity)
+	if err != nil {
+		return nil, fmt.Errorf("custom rule %q: severity: %w", r.Name, err)
+	}
+	ruleType := lint.NewRuleType(rt)
+	metadata := lint.RuleMetadata{Description: r.Description}
+	switch r.Target {
+	case "file":
+		return &lint.FileRule{Name: name, RuleType: ruleType, Metadata: metadata, LintFile: func(f *desc.FileDescriptor) []lint.Problem {
+			return c.check(f)
+		}}, nil
+	case "service":
+		return &lint.ServiceRule{Name: name, RuleType: ruleType, Metadata: metadata, LintService: func(s *desc.ServiceDescriptor) []lint.Problem {
+			return c.check(s)
+		}}, nil
+	case "method":
+		return &lint.MethodRule{Name: name, RuleType: ruleType, Metadata: metadata, LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+			return c.check(m)
+		}}, nil
+	case "message":
+		return &lint.MessageRule{Name: name, RuleType: ruleType, Metadata: metadata, LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
+			return c.check(m)
+		}}, nil
+	case "field":
+		return &lint.FieldRule{Name: name, RuleType: ruleType, Metadata: metadata, LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+			return c.check(f)
+		}}, nil
+	case "enum":
+		return &lint.EnumRule{Name: name, RuleType: ruleType, Metadata: metadata, LintEnum: func(e *desc.EnumDescriptor) []lint.Problem {
+			return c.check(e)
+		}}, nil
+	}
+	return nil, fmt.Errorf("custom rule %q: unknown target %q", r.Name, r.Target)
+}
+
+// checker evaluates the expression of a custom rule.
+type checker struct {
+	prg cel.Program
+	msg *template.Template
+}
+
+// check returns a problem if the expression is true for the descriptor.
+// Rules cannot return errors, so a failing expression panics, which the
+// linter reports as an error.
+func (c *checker) check(d desc.Descriptor) []lint.Problem {
+	v := view(d)
+	out, _, err := c.prg.Eval(v)
+	if err != nil {
+		panic(fmt.Errorf("evaluating report_if for %s: %w", d.GetFullyQualifiedName(), err))
+	}
+	if report, ok := out.Value().(bool); !ok || !report {
+		return nil
+	}
+	var msg bytes.Buffer
+	if err := c.msg.Execute(&msg, v); err != nil {
+		panic(fmt.Errorf("executing message for %s: %w", d.GetFullyQualifiedName(), err))
+	}
+	return []lint.Problem{{Message: msg.String(), Descriptor: d}}
+}
+
+// view returns the variables describing the descriptor.
+func view(d desc.Descriptor) map[string]any {
+	v := map[string]any{
+		"kind":             "",
+		"name":             d.GetName(),
+		"full_name":        d.GetFullyQualifiedName(),
+		"parent":           "",
+		"file":             d.GetFile().GetName(),
+		"package_name":     d.GetFile().GetPackage(),
+		"comments":         d.GetSourceInfo().GetLeadingComments(),
+		"options":          protoMap(protoadapt.MessageV2Of(d.GetOptions())),
+		"services":         []string{},
+		"messages":         []string{},
+		"enums":            []string{},
+		"methods":          []string{},
+		"input_type":       "",
+		"output_type":      "",
+		"client_streaming": false,
+		"server_streaming": false,
+		"fields":           []string{},
+		"resource":         nil,
+		"type_name":        "",
+		"number":           0,
+		"repeated":         false,
+		"field_behavior":   []string{},
+		"values":           []string{},
+	}
+	if p := d.GetParent(); p != nil {
+		if _, isFile := p.(*desc.FileDescriptor); !isFile {
+			v["parent"] = p.GetFullyQualifiedName()
+		}
+	}
+	switch d := d.(type) {
+	case *desc.FileDescriptor:
+		v["kind"] = "file"
+		v["full_name"] = d.GetPackage()
+		v["services"] = names(d.GetServices())
+		v["messages"] = names(d.GetMessageTypes())
+		v["enums"] = names(d.GetEnumTypes())
+	case *desc.ServiceDescriptor:
+		v["kind"] = "service"
+		v["methods"] = names(d.GetMethods())
+	case *desc.MethodDescriptor:
+		v["kind"] = "method"
+		v["input_type"] = d.GetInputType().GetFullyQualifiedName()
+		v["output_type"] = d.GetOutputType().GetFullyQualifiedName()
+		v["client_streaming"] = d.IsClientStreaming()
+		v["server_streaming"] = d.IsServerStreaming()
+	case *desc.MessageDescriptor:
+		v["kind"] = "message"
+		v["fields"] = names(d.GetFields())
+		if r := descutil.GetResource(d); r != nil {
+			v["resource"] = protoMap(r)
+		}
+	case *desc.FieldDescriptor:
+		v["kind"] = "field"
+		v["type_name"] = descutil.GetTypeName(d)
+		v["number"] = int(d.GetNumber())
+		v["repeated"] = d.IsRepeated()
+		if behavior := descutil.GetFieldBehavior(d); behavior.Len() > 0 {
+			v["field_behavior"] = behavior.Elements()
+		}
+	case *desc.EnumDescriptor:
+		v["kind"] = "enum"
+		v["values"] = names(d.GetValues())
+	}
+	return v
+}
+
+func names[D desc.Descriptor](descriptors []D) []string {
+	n := make([]string, 0, len(descriptors))
+	for _, d := range descriptors {
+		n = append(n, d.GetName())
+	}
+	return n
+}
+
+// protoMap returns the message as a map, with the JSON field names as in the
+// proto definition, or an empty map if it is unset.
+func protoMap(m proto.Message) map[string]any {
+	v := map[string]any{}
+	if m == nil {
+		return v
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return v
+	}
+	_ = json.Unmarshal(b, &v)
+	return v
+}
@@ -0,0 +1,123 @@
+package customrules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aep-dev/api-linter/aep/ruletest"
+	"github.com/aep-dev/api-linter/lint"
+)
+
+func TestNew(t *testing.T) {
+	f := ruletest.ParseProto3String(t, `
+		import "aep/api/field_info.proto";
+		import "aep/api/resource.proto";
+		import "google/protobuf/timestamp.proto";
+
+		package acme.library.v1;
+
+		service Library {
+			rpc GetBook(GetBookRequest) returns (Book);
+		}
+
+		message GetBookRequest {
+			string path = 1 [(aep.api.field_info).field_behavior = FIELD_BEHAVIOR_REQUIRED];
+		}
+
+		message Book {
+			option (aep.api.resource) = {
+				type: "library.example.com/book"
+				pattern: "books/{book_id}"
+			};
+			string path = 1;
+			bytes data = 2;
+		}
+
+		message Shelf {
+			option (aep.api.resource) = {
+				type: "library.example.com/shelf"
+				pattern: "shelves/{shelf_id}"
+			};
+			string path = 1;
+			repeated string book_ids = 2 [deprecated = true];
+			google.protobuf.Timestamp create_time = 3;
+		}
+
+		enum State {
+			STATE_UNSPECIFIED = 0;
+		}
+	`)
+	book := f.FindMessage("acme.library.v1.Book")
+	tests := []struct {
+		name     string
+		target   string
+		reportIf string
+		message  string
+		problems ruletest.Problems
+	}{
+		{
+			"FieldName", "field", `name == "data"`, "Field {{.full_name}} is too vague.",
+			ruletest.Problems{{Descriptor: book.FindFieldByName("data"), Message: "Field acme.library.v1.Book.data is too vague."}},
+		},
+		{
+			"ResourceField", "message", `resource != null && !("create_time" in fields)`, "{{.name}} must have a create_time field.",
+			ruletest.Problems{{Descriptor: book, Message: "Book must have a create_time field."}},
+		},
+		{
+			"ResourcePattern", "message", `resource != null && resource.pattern[0].startsWith("shelves/")`, "Shelf.",
+			ruletest.Problems{{Descriptor: f.FindMessage("acme.library.v1.Shelf"), Message: "Shelf."}},
+		},
+		{
+			"FieldBehavior", "field", `"REQUIRED" in field_behavior`, "Required.",
+			ruletest.Problems{{Descriptor: f.FindMessage("acme.library.v1.GetBookRequest").FindFieldByName("path"), Message: "Required."}},
+		},
+		{
+			"Options", "field", `has(options.deprecated) && options.deprecated && repeated && type_name == "string"`, "Deprecated.",
+			ruletest.Problems{{Descriptor: f.FindMessage("acme.library.v1.Shelf").FindFieldByName("book_ids"), Message: "Deprecated."}},
+		},
+		{
+			"Method", "method", `!output_type.endsWith(name.substring(3))`, "Bad.",
+			nil,
+		},
+		{
+			"Enum", "enum", `values.size() == 1`, "{{.name}} has a single value.",
+			ruletest.Problems{{Descriptor: f.FindEnum("acme.library.v1.State"), Message: "State has a single value."}},
+		},
+		{
+			"File", "file", `package_name.startsWith("acme.") && size(services) > 0`, "File {{.file}}.",
+			ruletest.Problems{{Descriptor: f, Message: "File test.proto."}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := New(lint.CustomRule{Name: "custom::test", Target: test.target, ReportIf: test.reportIf, Message: test.message})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := test.problems.Diff(rule.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		rule lint.CustomRule
+		want string
+	}{
+		{"Syntax", lint.CustomRule{Name: "custom::a", Target: "field", ReportIf: `name ==`}, "report_if"},
+		{"UnknownVariable", lint.CustomRule{Name: "custom::a", Target: "field", ReportIf: `nam == "a"`}, "undeclared reference"},
+		{"NotBool", lint.CustomRule{Name: "custom::a", Target: "field", ReportIf: `name`}, "must be a bool expression"},
+		{"Message", lint.CustomRule{Name: "custom::a", Target: "field", ReportIf: `true`, Message: "{{.name"}, "message"},
+		{"Target", lint.CustomRule{Name: "custom::a", Target: "oneof", ReportIf: `true`}, "unknown target"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := New(test.rule); err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("New got error %v, but want one containing %q", err, test.want)
+			}
+		})
+	}
+}
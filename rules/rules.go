@@ -50,6 +50,8 @@
 package rules
 
 import (
+	"fmt"
+
 	"github.com/aep-dev/api-linter/lint"
 	"github.com/aep-dev/api-linter/rules/aep0004"
 	"github.com/aep-dev/api-linter/rules/aep0121"
@@ -76,6 +78,7 @@ import (
 	"github.com/aep-dev/api-linter/rules/aep0191"
 	"github.com/aep-dev/api-linter/rules/aep0192"
 	"github.com/aep-dev/api-linter/rules/aep0216"
+	"github.com/aep-dev/api-linter/rules/internal/customrules"
 )
 
 type addRulesFuncType func(lint.RuleRegistry) error
@@ -113,6 +116,21 @@ func Add(r lint.RuleRegistry) error {
 	return addAEPRules(r, aepAddRulesFuncs)
 }
 
+// AddCustomRules compiles the custom rules declared in the configs, and adds
+// them to the given registry.
+func AddCustomRules(r lint.RuleRegistry, configs lint.Configs) error {
+	for _, custom := range configs.CustomRules() {
+		rule, err := customrules.New(custom)
+		if err != nil {
+			return err
+		}
+		if err := r.RegisterRule(rule); err != nil {
+			return fmt.Errorf("custom rule %q: %w", custom.Name, err)
+		}
+	}
+	return nil
+}
+
 func addAEPRules(r lint.RuleRegistry, addRulesFuncs []addRulesFuncType) error {
 	for _, addRules := range addRulesFuncs {
 		if err := addRules(r); err != nil {
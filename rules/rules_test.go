@@ -49,3 +49,26 @@ func TestAdd(t *testing.T) {
 		t.Errorf("Add got an error: %v", err)
 	}
 }
+
+func TestAdd_FixableRules(t *testing.T) {
+	registry := lint.NewRuleRegistry()
+	if err := Add(registry); err != nil {
+		t.Fatal(err)
+	}
+	// The quality checker ties Metadata.Fixable to the suggestions of each
+	// rule; check here that the metadata of the registry carries it.
+	fixable := map[lint.RuleName]bool{}
+	for name, rule := range registry {
+		if lint.GetRuleMetadata(rule).Fixable {
+			fixable[name] = true
+		}
+	}
+	for _, name := range []lint.RuleName{"core::0191::proto-version", "core::0131::request-message-name", "core::0142::time-field-type"} {
+		if !fixable[name] {
+			t.Errorf("GetRuleMetadata(%q).Fixable is false, but want true", name)
+		}
+	}
+	if name := lint.RuleName("core::0148::field-behavior"); fixable[name] {
+		t.Errorf("GetRuleMetadata(%q).Fixable is true, but want false", name)
+	}
+}